@@ -54,7 +54,7 @@ func (e *Event) Verify(pubKey *common.PublicKey) bool {
 	hash := e.Hash()
 	r, s, err := crypto.DecodeSignature(string(e.Sign))
 	if err != nil {
-		log.Fatal(err)
+		return false
 	}
 
 	return pubKey.Verify(hash.Bytes(), r, s)
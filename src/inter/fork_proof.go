@@ -0,0 +1,60 @@
+package inter
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// ForkProof is an evidence of cheating:
+// two different events signed by the same creator with the same index.
+type ForkProof struct {
+	First  *Event
+	Second *Event
+}
+
+// NewForkProof makes proof if events conflict, returns nil otherwise.
+func NewForkProof(a, b *Event) *ForkProof {
+	if a == nil || b == nil ||
+		a.Creator != b.Creator ||
+		a.Index != b.Index ||
+		a.Hash() == b.Hash() {
+		return nil
+	}
+	return &ForkProof{
+		First:  a,
+		Second: b,
+	}
+}
+
+// Creator returns cheater.
+func (p *ForkProof) Creator() hash.Peer {
+	return p.First.Creator
+}
+
+// Verify checks proof consistency and signs of events by creator's public key.
+func (p *ForkProof) Verify(pubKey *common.PublicKey) bool {
+	if NewForkProof(p.First, p.Second) == nil {
+		return false
+	}
+	return p.First.Verify(pubKey) && p.Second.Verify(pubKey)
+}
+
+// ToWire converts to proto.Message.
+func (p *ForkProof) ToWire() *wire.ForkProof {
+	return &wire.ForkProof{
+		First:  p.First.ToWire(),
+		Second: p.Second.ToWire(),
+	}
+}
+
+// WireToForkProof converts from wire.
+func WireToForkProof(w *wire.ForkProof) *ForkProof {
+	if w == nil {
+		return nil
+	}
+	return &ForkProof{
+		First:  WireToEvent(w.First),
+		Second: WireToEvent(w.Second),
+	}
+}
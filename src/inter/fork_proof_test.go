@@ -0,0 +1,72 @@
+package inter
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+func TestForkProof(t *testing.T) {
+	key := crypto.GenerateKey()
+	creator := hash.PeerOfPubkey(key.Public())
+
+	newEvent := func(index uint64, time Timestamp) *Event {
+		e := &Event{
+			Index:       index,
+			Creator:     creator,
+			Parents:     hash.NewEvents(hash.ZeroEvent),
+			LamportTime: time,
+		}
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+
+	a, b, c := newEvent(1, 1), newEvent(1, 2), newEvent(2, 2)
+
+	t.Run("no fork", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Nil(NewForkProof(a, a))
+		assert.Nil(NewForkProof(a, c))
+		assert.Nil(NewForkProof(a, nil))
+	})
+
+	t.Run("fork", func(t *testing.T) {
+		assert := assert.New(t)
+
+		proof := NewForkProof(a, b)
+		if !assert.NotNil(proof) {
+			return
+		}
+		assert.Equal(creator, proof.Creator())
+		assert.True(proof.Verify(key.Public()))
+		assert.False(proof.Verify(crypto.GenerateKey().Public()))
+	})
+
+	t.Run("serialization", func(t *testing.T) {
+		assert := assert.New(t)
+
+		proof := NewForkProof(a, b)
+
+		buf, err := proto.Marshal(proof.ToWire())
+		if !assert.NoError(err) {
+			return
+		}
+		w := &wire.ForkProof{}
+		if !assert.NoError(proto.Unmarshal(buf, w)) {
+			return
+		}
+		got := WireToForkProof(w)
+
+		assert.Equal(a.Hash(), got.First.Hash())
+		assert.Equal(b.Hash(), got.Second.Hash())
+		assert.True(got.Verify(key.Public()))
+	})
+}
@@ -1,36 +1,106 @@
 package inter
 
 import (
+	"github.com/golang/protobuf/proto"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 )
 
-// InternalTransaction is for stake transfer.
+// InternalTransaction is for stake operations.
+// It is a plain view of versioned envelope, the Kind defines used fields.
 type InternalTransaction struct {
 	Index      uint64
 	Amount     uint64
 	Receiver   hash.Peer
 	UntilBlock uint64
+	Kind       InternalTransactionKind
+	Source     hash.Peer
+	PubKey     []byte
+	Version    uint32
+}
+
+// ActualKind returns kind of transaction.
+// Transfer with UntilBlock is a delegation (as in version 0).
+func (tx *InternalTransaction) ActualKind() InternalTransactionKind {
+	if tx.Kind == TransferTx && tx.UntilBlock != 0 {
+		return DelegateTx
+	}
+	return tx.Kind
 }
 
 // ToWire converts to wire.
 func (tx *InternalTransaction) ToWire() *wire.InternalTransaction {
-	return &wire.InternalTransaction{
+	if tx.Version == 0 {
+		return tx.toLegacyWire()
+	}
+
+	w := &wire.InternalTransaction{
+		Index:   tx.Index,
+		Version: tx.Version,
+	}
+	if k := txKinds[tx.ActualKind()]; k != nil {
+		k.encode(tx, w)
+	}
+	return w
+}
+
+func (tx *InternalTransaction) toLegacyWire() *wire.InternalTransaction {
+	kind := tx.Kind
+	if kind == DelegateTx {
+		kind = TransferTx
+	}
+
+	w := &wire.InternalTransaction{
 		Index:      tx.Index,
 		Amount:     tx.Amount,
 		Receiver:   tx.Receiver.Hex(),
 		UntilBlock: tx.UntilBlock,
+		Kind:       wire.InternalTransactionKind(kind),
+	}
+	// NOTE: empty source is omitted to keep hash of transfers
+	if !tx.Source.IsEmpty() {
+		w.Source = tx.Source.Hex()
 	}
+	return w
 }
 
 // WireToInternalTransaction converts from wire.
+// Unknown payload and newer version are decoded as UnknownTx.
 func WireToInternalTransaction(w *wire.InternalTransaction) *InternalTransaction {
-	return &InternalTransaction{
+	if w.Version == 0 {
+		return wireToLegacyInternalTransaction(w)
+	}
+
+	tx := &InternalTransaction{
+		Index:   w.Index,
+		Version: w.Version,
+		Kind:    UnknownTx,
+	}
+	if w.Version > InternalTxVersion {
+		return tx
+	}
+	for kind, k := range txKinds {
+		if k.decode(w, tx) {
+			tx.Kind = kind
+			break
+		}
+	}
+	return tx
+}
+
+func wireToLegacyInternalTransaction(w *wire.InternalTransaction) *InternalTransaction {
+	tx := &InternalTransaction{
 		Index:      w.Index,
 		Amount:     w.Amount,
 		Receiver:   hash.HexToPeer(w.Receiver),
 		UntilBlock: w.UntilBlock,
+		Kind:       InternalTransactionKind(w.Kind),
+	}
+	if w.Source != "" {
+		tx.Source = hash.HexToPeer(w.Source)
 	}
+	return tx
 }
 
 // InternalTransactionsToWire converts to wire.
@@ -58,3 +128,18 @@ func WireToInternalTransactions(tt []*wire.InternalTransaction) []*InternalTrans
 
 	return res
 }
+
+/*
+ * Utils:
+ */
+
+// TransactionHashOf calcs hash of transaction.
+// It is a hash of sender and signed transaction content,
+// so the same transaction gets the same hash at any node.
+func TransactionHashOf(sender hash.Peer, tx *InternalTransaction) hash.Transaction {
+	buf, err := proto.Marshal(tx.ToWire())
+	if err != nil {
+		log.Fatal(err)
+	}
+	return hash.Transaction(hash.Of(sender.Bytes(), buf))
+}
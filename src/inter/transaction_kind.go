@@ -0,0 +1,182 @@
+package inter
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+// InternalTxVersion is the latest version of internal transaction envelope.
+const InternalTxVersion = 1
+
+// InternalTransactionKind is a kind of internal transaction.
+type InternalTransactionKind int32
+
+const (
+	// UnknownTx is a kind of undecodable transaction.
+	UnknownTx = InternalTransactionKind(-1)
+	// TransferTx moves stake to receiver.
+	TransferTx = InternalTransactionKind(wire.InternalTransactionKind_TRANSFER)
+	// SlashTx penalises receiver with a proven fork.
+	SlashTx = InternalTransactionKind(wire.InternalTransactionKind_SLASH)
+	// UndelegateTx cancels delegation to receiver until block before expiration.
+	UndelegateTx = InternalTransactionKind(wire.InternalTransactionKind_UNDELEGATE)
+	// RedelegateTx moves delegation until block from source to receiver.
+	RedelegateTx = InternalTransactionKind(wire.InternalTransactionKind_REDELEGATE)
+	// DelegateTx delegates stake to receiver until block.
+	DelegateTx = InternalTransactionKind(wire.InternalTransactionKind_DELEGATE)
+	// ValidatorRegistrationTx registers public key of sender.
+	ValidatorRegistrationTx = InternalTransactionKind(wire.InternalTransactionKind_VALIDATOR_REGISTRATION)
+)
+
+// TxKind is a registered kind of internal transaction.
+type TxKind struct {
+	Name string
+	// encode puts transaction fields into typed payload.
+	encode func(*InternalTransaction, *wire.InternalTransaction)
+	// decode gets transaction fields from typed payload,
+	// returns false if payload is of other kind.
+	decode func(*wire.InternalTransaction, *InternalTransaction) bool
+}
+
+var txKinds = make(map[InternalTransactionKind]*TxKind)
+
+// RegisterTxKind adds kind of internal transaction into registry.
+func RegisterTxKind(
+	kind InternalTransactionKind,
+	name string,
+	encode func(*InternalTransaction, *wire.InternalTransaction),
+	decode func(*wire.InternalTransaction, *InternalTransaction) bool,
+) {
+	if _, ok := txKinds[kind]; ok {
+		panic(fmt.Sprintf("internal transaction kind %d is registered already", kind))
+	}
+	txKinds[kind] = &TxKind{
+		Name:   name,
+		encode: encode,
+		decode: decode,
+	}
+}
+
+// IsKnown returns true if kind is registered.
+func (k InternalTransactionKind) IsKnown() bool {
+	_, ok := txKinds[k]
+	return ok
+}
+
+// String returns human readable string representation.
+func (k InternalTransactionKind) String() string {
+	if kind, ok := txKinds[k]; ok {
+		return kind.Name
+	}
+	return fmt.Sprintf("unknown(%d)", int32(k))
+}
+
+func init() {
+	RegisterTxKind(TransferTx, "transfer",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_Transfer{Transfer: &wire.Transfer{
+				Receiver: tx.Receiver.Hex(),
+				Amount:   tx.Amount,
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetTransfer()
+			if p == nil {
+				return false
+			}
+			tx.Receiver = hash.HexToPeer(p.Receiver)
+			tx.Amount = p.Amount
+			return true
+		})
+
+	RegisterTxKind(DelegateTx, "delegate",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_Delegate{Delegate: &wire.Delegate{
+				Receiver:   tx.Receiver.Hex(),
+				Amount:     tx.Amount,
+				UntilBlock: tx.UntilBlock,
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetDelegate()
+			if p == nil {
+				return false
+			}
+			tx.Receiver = hash.HexToPeer(p.Receiver)
+			tx.Amount = p.Amount
+			tx.UntilBlock = p.UntilBlock
+			return true
+		})
+
+	RegisterTxKind(UndelegateTx, "undelegate",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_Undelegate{Undelegate: &wire.Undelegate{
+				Receiver:   tx.Receiver.Hex(),
+				Amount:     tx.Amount,
+				UntilBlock: tx.UntilBlock,
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetUndelegate()
+			if p == nil {
+				return false
+			}
+			tx.Receiver = hash.HexToPeer(p.Receiver)
+			tx.Amount = p.Amount
+			tx.UntilBlock = p.UntilBlock
+			return true
+		})
+
+	RegisterTxKind(RedelegateTx, "redelegate",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_Redelegate{Redelegate: &wire.Redelegate{
+				Source:     tx.Source.Hex(),
+				Receiver:   tx.Receiver.Hex(),
+				Amount:     tx.Amount,
+				UntilBlock: tx.UntilBlock,
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetRedelegate()
+			if p == nil {
+				return false
+			}
+			tx.Source = hash.HexToPeer(p.Source)
+			tx.Receiver = hash.HexToPeer(p.Receiver)
+			tx.Amount = p.Amount
+			tx.UntilBlock = p.UntilBlock
+			return true
+		})
+
+	RegisterTxKind(SlashTx, "slash",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_Slash{Slash: &wire.Slash{
+				Offender: tx.Receiver.Hex(),
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetSlash()
+			if p == nil {
+				return false
+			}
+			tx.Receiver = hash.HexToPeer(p.Offender)
+			return true
+		})
+
+	RegisterTxKind(ValidatorRegistrationTx, "validator-registration",
+		func(tx *InternalTransaction, w *wire.InternalTransaction) {
+			w.Payload = &wire.InternalTransaction_ValidatorRegistration{ValidatorRegistration: &wire.ValidatorRegistration{
+				PubKey: tx.PubKey,
+			}}
+		},
+		func(w *wire.InternalTransaction, tx *InternalTransaction) bool {
+			p := w.GetValidatorRegistration()
+			if p == nil {
+				return false
+			}
+			tx.PubKey = p.PubKey
+			return true
+		})
+}
@@ -0,0 +1,125 @@
+package inter
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+)
+
+func TestInternalTransactionSerialization(t *testing.T) {
+	assert := assert.New(t)
+
+	txs := []*InternalTransaction{
+		{
+			Index:    1,
+			Amount:   10,
+			Receiver: hash.FakePeer(),
+		},
+		{
+			Index:      2,
+			Amount:     10,
+			Receiver:   hash.FakePeer(),
+			UntilBlock: 100,
+			Kind:       RedelegateTx,
+			Source:     hash.FakePeer(),
+		},
+	}
+
+	for _, tx := range txs {
+		assert.Equal(tx, WireToInternalTransaction(tx.ToWire()))
+	}
+
+	assert.Empty(txs[0].ToWire().Source, "empty source is omitted")
+}
+
+func TestInternalTransactionEnvelope(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		assert := assert.New(t)
+
+		txs := []*InternalTransaction{
+			{
+				Index:    1,
+				Amount:   10,
+				Receiver: hash.FakePeer(),
+				Kind:     TransferTx,
+			},
+			{
+				Index:      2,
+				Amount:     10,
+				Receiver:   hash.FakePeer(),
+				UntilBlock: 100,
+				Kind:       DelegateTx,
+			},
+			{
+				Index:      3,
+				Amount:     10,
+				Receiver:   hash.FakePeer(),
+				UntilBlock: 100,
+				Kind:       UndelegateTx,
+			},
+			{
+				Index:      4,
+				Amount:     10,
+				Receiver:   hash.FakePeer(),
+				UntilBlock: 100,
+				Kind:       RedelegateTx,
+				Source:     hash.FakePeer(),
+			},
+			{
+				Index:    5,
+				Receiver: hash.FakePeer(),
+				Kind:     SlashTx,
+			},
+			{
+				Index:  6,
+				Kind:   ValidatorRegistrationTx,
+				PubKey: []byte("fake public key"),
+			},
+		}
+
+		for _, tx := range txs {
+			tx.Version = InternalTxVersion
+			w := tx.ToWire()
+			assert.Empty(w.Receiver, "legacy fields are not used")
+			assert.Equal(tx, WireToInternalTransaction(w), tx.Kind.String())
+		}
+	})
+
+	t.Run("legacy hash", func(t *testing.T) {
+		assert := assert.New(t)
+
+		sender, receiver := hash.FakePeer(), hash.FakePeer()
+		legacy := &wire.InternalTransaction{
+			Index:      1,
+			Amount:     10,
+			Receiver:   receiver.Hex(),
+			UntilBlock: 100,
+		}
+		buf, err := proto.Marshal(legacy)
+		if !assert.NoError(err) {
+			return
+		}
+
+		tx := WireToInternalTransaction(legacy)
+		assert.Equal(DelegateTx, tx.ActualKind())
+		assert.Equal(hash.Transaction(hash.Of(sender.Bytes(), buf)), TransactionHashOf(sender, tx))
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(UnknownTx, WireToInternalTransaction(&wire.InternalTransaction{
+			Index:   1,
+			Version: InternalTxVersion,
+		}).Kind, "no payload")
+		assert.Equal(UnknownTx, WireToInternalTransaction(&wire.InternalTransaction{
+			Index:   1,
+			Version: InternalTxVersion + 1,
+			Payload: &wire.InternalTransaction_Transfer{Transfer: &wire.Transfer{}},
+		}).Kind, "newer version")
+	})
+}
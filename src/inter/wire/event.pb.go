@@ -20,21 +20,370 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
-type InternalTransaction struct {
-	Index                uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+type InternalTransactionKind int32
+
+const (
+	InternalTransactionKind_TRANSFER               InternalTransactionKind = 0
+	InternalTransactionKind_SLASH                  InternalTransactionKind = 1
+	InternalTransactionKind_UNDELEGATE             InternalTransactionKind = 2
+	InternalTransactionKind_REDELEGATE             InternalTransactionKind = 3
+	InternalTransactionKind_DELEGATE               InternalTransactionKind = 4
+	InternalTransactionKind_VALIDATOR_REGISTRATION InternalTransactionKind = 5
+)
+
+var InternalTransactionKind_name = map[int32]string{
+	0: "TRANSFER",
+	1: "SLASH",
+	2: "UNDELEGATE",
+	3: "REDELEGATE",
+	4: "DELEGATE",
+	5: "VALIDATOR_REGISTRATION",
+}
+
+var InternalTransactionKind_value = map[string]int32{
+	"TRANSFER":               0,
+	"SLASH":                  1,
+	"UNDELEGATE":             2,
+	"REDELEGATE":             3,
+	"DELEGATE":               4,
+	"VALIDATOR_REGISTRATION": 5,
+}
+
+func (x InternalTransactionKind) String() string {
+	return proto.EnumName(InternalTransactionKind_name, int32(x))
+}
+
+func (InternalTransactionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{0}
+}
+
+type Transfer struct {
+	Receiver             string   `protobuf:"bytes,1,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
 	Amount               uint64   `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	Receiver             string   `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{0}
+}
+
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Transfer.Unmarshal(m, b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return xxx_messageInfo_Transfer.Size(m)
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
+func (m *Transfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Transfer) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type Delegate struct {
+	Receiver             string   `protobuf:"bytes,1,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	UntilBlock           uint64   `protobuf:"varint,3,opt,name=UntilBlock,proto3" json:"UntilBlock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delegate) Reset()         { *m = Delegate{} }
+func (m *Delegate) String() string { return proto.CompactTextString(m) }
+func (*Delegate) ProtoMessage()    {}
+func (*Delegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{1}
+}
+
+func (m *Delegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delegate.Unmarshal(m, b)
+}
+func (m *Delegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delegate.Marshal(b, m, deterministic)
+}
+func (m *Delegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegate.Merge(m, src)
+}
+func (m *Delegate) XXX_Size() int {
+	return xxx_messageInfo_Delegate.Size(m)
+}
+func (m *Delegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegate proto.InternalMessageInfo
+
+func (m *Delegate) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Delegate) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Delegate) GetUntilBlock() uint64 {
+	if m != nil {
+		return m.UntilBlock
+	}
+	return 0
+}
+
+type Undelegate struct {
+	Receiver             string   `protobuf:"bytes,1,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	UntilBlock           uint64   `protobuf:"varint,3,opt,name=UntilBlock,proto3" json:"UntilBlock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Undelegate) Reset()         { *m = Undelegate{} }
+func (m *Undelegate) String() string { return proto.CompactTextString(m) }
+func (*Undelegate) ProtoMessage()    {}
+func (*Undelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{2}
+}
+
+func (m *Undelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Undelegate.Unmarshal(m, b)
+}
+func (m *Undelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Undelegate.Marshal(b, m, deterministic)
+}
+func (m *Undelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Undelegate.Merge(m, src)
+}
+func (m *Undelegate) XXX_Size() int {
+	return xxx_messageInfo_Undelegate.Size(m)
+}
+func (m *Undelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Undelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Undelegate proto.InternalMessageInfo
+
+func (m *Undelegate) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Undelegate) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Undelegate) GetUntilBlock() uint64 {
+	if m != nil {
+		return m.UntilBlock
+	}
+	return 0
+}
+
+type Redelegate struct {
+	Source               string   `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Receiver             string   `protobuf:"bytes,2,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Amount               uint64   `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
 	UntilBlock           uint64   `protobuf:"varint,4,opt,name=UntilBlock,proto3" json:"UntilBlock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *Redelegate) Reset()         { *m = Redelegate{} }
+func (m *Redelegate) String() string { return proto.CompactTextString(m) }
+func (*Redelegate) ProtoMessage()    {}
+func (*Redelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
+}
+
+func (m *Redelegate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Redelegate.Unmarshal(m, b)
+}
+func (m *Redelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Redelegate.Marshal(b, m, deterministic)
+}
+func (m *Redelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegate.Merge(m, src)
+}
+func (m *Redelegate) XXX_Size() int {
+	return xxx_messageInfo_Redelegate.Size(m)
+}
+func (m *Redelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegate proto.InternalMessageInfo
+
+func (m *Redelegate) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Redelegate) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Redelegate) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Redelegate) GetUntilBlock() uint64 {
+	if m != nil {
+		return m.UntilBlock
+	}
+	return 0
+}
+
+type Slash struct {
+	Offender             string   `protobuf:"bytes,1,opt,name=Offender,proto3" json:"Offender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Slash) Reset()         { *m = Slash{} }
+func (m *Slash) String() string { return proto.CompactTextString(m) }
+func (*Slash) ProtoMessage()    {}
+func (*Slash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
+}
+
+func (m *Slash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Slash.Unmarshal(m, b)
+}
+func (m *Slash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Slash.Marshal(b, m, deterministic)
+}
+func (m *Slash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slash.Merge(m, src)
+}
+func (m *Slash) XXX_Size() int {
+	return xxx_messageInfo_Slash.Size(m)
+}
+func (m *Slash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slash proto.InternalMessageInfo
+
+func (m *Slash) GetOffender() string {
+	if m != nil {
+		return m.Offender
+	}
+	return ""
+}
+
+type ValidatorRegistration struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorRegistration) Reset()         { *m = ValidatorRegistration{} }
+func (m *ValidatorRegistration) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRegistration) ProtoMessage()    {}
+func (*ValidatorRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{5}
+}
+
+func (m *ValidatorRegistration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorRegistration.Unmarshal(m, b)
+}
+func (m *ValidatorRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorRegistration.Marshal(b, m, deterministic)
+}
+func (m *ValidatorRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRegistration.Merge(m, src)
+}
+func (m *ValidatorRegistration) XXX_Size() int {
+	return xxx_messageInfo_ValidatorRegistration.Size(m)
+}
+func (m *ValidatorRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRegistration proto.InternalMessageInfo
+
+func (m *ValidatorRegistration) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// InternalTransaction is a versioned envelope.
+// Version 0 uses the flat fields 2-6 (legacy),
+// the following versions use the typed Payload.
+type InternalTransaction struct {
+	Index      uint64                  `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Amount     uint64                  `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Receiver   string                  `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	UntilBlock uint64                  `protobuf:"varint,4,opt,name=UntilBlock,proto3" json:"UntilBlock,omitempty"`
+	Kind       InternalTransactionKind `protobuf:"varint,5,opt,name=Kind,proto3,enum=wire.InternalTransactionKind" json:"Kind,omitempty"`
+	Source     string                  `protobuf:"bytes,6,opt,name=Source,proto3" json:"Source,omitempty"`
+	Version    uint32                  `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//	*InternalTransaction_Transfer
+	//	*InternalTransaction_Delegate
+	//	*InternalTransaction_Undelegate
+	//	*InternalTransaction_Redelegate
+	//	*InternalTransaction_Slash
+	//	*InternalTransaction_ValidatorRegistration
+	Payload              isInternalTransaction_Payload `protobuf_oneof:"Payload"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
 func (m *InternalTransaction) Reset()         { *m = InternalTransaction{} }
 func (m *InternalTransaction) String() string { return proto.CompactTextString(m) }
 func (*InternalTransaction) ProtoMessage()    {}
 func (*InternalTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{0}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{6}
 }
 
 func (m *InternalTransaction) XXX_Unmarshal(b []byte) error {
@@ -83,6 +432,266 @@ func (m *InternalTransaction) GetUntilBlock() uint64 {
 	return 0
 }
 
+func (m *InternalTransaction) GetKind() InternalTransactionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return InternalTransactionKind_TRANSFER
+}
+
+func (m *InternalTransaction) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *InternalTransaction) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type isInternalTransaction_Payload interface {
+	isInternalTransaction_Payload()
+}
+
+type InternalTransaction_Transfer struct {
+	Transfer *Transfer `protobuf:"bytes,8,opt,name=Transfer,proto3,oneof"`
+}
+
+type InternalTransaction_Delegate struct {
+	Delegate *Delegate `protobuf:"bytes,9,opt,name=Delegate,proto3,oneof"`
+}
+
+type InternalTransaction_Undelegate struct {
+	Undelegate *Undelegate `protobuf:"bytes,10,opt,name=Undelegate,proto3,oneof"`
+}
+
+type InternalTransaction_Redelegate struct {
+	Redelegate *Redelegate `protobuf:"bytes,11,opt,name=Redelegate,proto3,oneof"`
+}
+
+type InternalTransaction_Slash struct {
+	Slash *Slash `protobuf:"bytes,12,opt,name=Slash,proto3,oneof"`
+}
+
+type InternalTransaction_ValidatorRegistration struct {
+	ValidatorRegistration *ValidatorRegistration `protobuf:"bytes,13,opt,name=ValidatorRegistration,proto3,oneof"`
+}
+
+func (*InternalTransaction_Transfer) isInternalTransaction_Payload() {}
+
+func (*InternalTransaction_Delegate) isInternalTransaction_Payload() {}
+
+func (*InternalTransaction_Undelegate) isInternalTransaction_Payload() {}
+
+func (*InternalTransaction_Redelegate) isInternalTransaction_Payload() {}
+
+func (*InternalTransaction_Slash) isInternalTransaction_Payload() {}
+
+func (*InternalTransaction_ValidatorRegistration) isInternalTransaction_Payload() {}
+
+func (m *InternalTransaction) GetPayload() isInternalTransaction_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetTransfer() *Transfer {
+	if x, ok := m.GetPayload().(*InternalTransaction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetDelegate() *Delegate {
+	if x, ok := m.GetPayload().(*InternalTransaction_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetUndelegate() *Undelegate {
+	if x, ok := m.GetPayload().(*InternalTransaction_Undelegate); ok {
+		return x.Undelegate
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetRedelegate() *Redelegate {
+	if x, ok := m.GetPayload().(*InternalTransaction_Redelegate); ok {
+		return x.Redelegate
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetSlash() *Slash {
+	if x, ok := m.GetPayload().(*InternalTransaction_Slash); ok {
+		return x.Slash
+	}
+	return nil
+}
+
+func (m *InternalTransaction) GetValidatorRegistration() *ValidatorRegistration {
+	if x, ok := m.GetPayload().(*InternalTransaction_ValidatorRegistration); ok {
+		return x.ValidatorRegistration
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*InternalTransaction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _InternalTransaction_OneofMarshaler, _InternalTransaction_OneofUnmarshaler, _InternalTransaction_OneofSizer, []interface{}{
+		(*InternalTransaction_Transfer)(nil),
+		(*InternalTransaction_Delegate)(nil),
+		(*InternalTransaction_Undelegate)(nil),
+		(*InternalTransaction_Redelegate)(nil),
+		(*InternalTransaction_Slash)(nil),
+		(*InternalTransaction_ValidatorRegistration)(nil),
+	}
+}
+
+func _InternalTransaction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*InternalTransaction)
+	// Payload
+	switch x := m.Payload.(type) {
+	case *InternalTransaction_Transfer:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Transfer); err != nil {
+			return err
+		}
+	case *InternalTransaction_Delegate:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Delegate); err != nil {
+			return err
+		}
+	case *InternalTransaction_Undelegate:
+		b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Undelegate); err != nil {
+			return err
+		}
+	case *InternalTransaction_Redelegate:
+		b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Redelegate); err != nil {
+			return err
+		}
+	case *InternalTransaction_Slash:
+		b.EncodeVarint(12<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Slash); err != nil {
+			return err
+		}
+	case *InternalTransaction_ValidatorRegistration:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorRegistration); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("InternalTransaction.Payload has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _InternalTransaction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*InternalTransaction)
+	switch tag {
+	case 8: // Payload.Transfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Transfer)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_Transfer{msg}
+		return true, err
+	case 9: // Payload.Delegate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Delegate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_Delegate{msg}
+		return true, err
+	case 10: // Payload.Undelegate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Undelegate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_Undelegate{msg}
+		return true, err
+	case 11: // Payload.Redelegate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Redelegate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_Redelegate{msg}
+		return true, err
+	case 12: // Payload.Slash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Slash)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_Slash{msg}
+		return true, err
+	case 13: // Payload.ValidatorRegistration
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ValidatorRegistration)
+		err := b.DecodeMessage(msg)
+		m.Payload = &InternalTransaction_ValidatorRegistration{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _InternalTransaction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*InternalTransaction)
+	// Payload
+	switch x := m.Payload.(type) {
+	case *InternalTransaction_Transfer:
+		s := proto.Size(x.Transfer)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InternalTransaction_Delegate:
+		s := proto.Size(x.Delegate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InternalTransaction_Undelegate:
+		s := proto.Size(x.Undelegate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InternalTransaction_Redelegate:
+		s := proto.Size(x.Redelegate)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InternalTransaction_Slash:
+		s := proto.Size(x.Slash)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *InternalTransaction_ValidatorRegistration:
+		s := proto.Size(x.ValidatorRegistration)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type Event struct {
 	Index                uint64                 `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
 	Creator              string                 `protobuf:"bytes,2,opt,name=Creator,proto3" json:"Creator,omitempty"`
@@ -100,7 +709,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{1}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{7}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -170,29 +779,108 @@ func (m *Event) GetSign() string {
 	return ""
 }
 
+type ForkProof struct {
+	First                *Event   `protobuf:"bytes,1,opt,name=First,proto3" json:"First,omitempty"`
+	Second               *Event   `protobuf:"bytes,2,opt,name=Second,proto3" json:"Second,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkProof) Reset()         { *m = ForkProof{} }
+func (m *ForkProof) String() string { return proto.CompactTextString(m) }
+func (*ForkProof) ProtoMessage()    {}
+func (*ForkProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{8}
+}
+
+func (m *ForkProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkProof.Unmarshal(m, b)
+}
+func (m *ForkProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkProof.Marshal(b, m, deterministic)
+}
+func (m *ForkProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkProof.Merge(m, src)
+}
+func (m *ForkProof) XXX_Size() int {
+	return xxx_messageInfo_ForkProof.Size(m)
+}
+func (m *ForkProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkProof proto.InternalMessageInfo
+
+func (m *ForkProof) GetFirst() *Event {
+	if m != nil {
+		return m.First
+	}
+	return nil
+}
+
+func (m *ForkProof) GetSecond() *Event {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("wire.InternalTransactionKind", InternalTransactionKind_name, InternalTransactionKind_value)
+	proto.RegisterType((*Transfer)(nil), "wire.Transfer")
+	proto.RegisterType((*Delegate)(nil), "wire.Delegate")
+	proto.RegisterType((*Undelegate)(nil), "wire.Undelegate")
+	proto.RegisterType((*Redelegate)(nil), "wire.Redelegate")
+	proto.RegisterType((*Slash)(nil), "wire.Slash")
+	proto.RegisterType((*ValidatorRegistration)(nil), "wire.ValidatorRegistration")
 	proto.RegisterType((*InternalTransaction)(nil), "wire.InternalTransaction")
 	proto.RegisterType((*Event)(nil), "wire.Event")
+	proto.RegisterType((*ForkProof)(nil), "wire.ForkProof")
 }
 
 func init() { proto.RegisterFile("event.proto", fileDescriptor_2d17a9d3f0ddf27e) }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x95, 0xe6, 0x4f, 0xe9, 0x85, 0xe9, 0x88, 0x90, 0x61, 0x40, 0x56, 0xa7, 0x4c, 0x19,
-	0xca, 0x13, 0x00, 0xea, 0x50, 0x09, 0x24, 0x64, 0xca, 0x03, 0x98, 0x70, 0x42, 0x16, 0xc9, 0xb9,
-	0x72, 0x4c, 0xe9, 0xc6, 0xce, 0x53, 0xa3, 0x98, 0x16, 0x75, 0x30, 0x9b, 0x7f, 0xdf, 0xf9, 0xfc,
-	0xdd, 0xe7, 0x83, 0x92, 0xb6, 0xc4, 0xbe, 0xd9, 0x38, 0xeb, 0x2d, 0x66, 0x9f, 0xc6, 0xd1, 0xfc,
-	0x0b, 0xce, 0x56, 0xec, 0xc9, 0xb1, 0xee, 0xd6, 0x4e, 0xf3, 0xa0, 0x5b, 0x6f, 0x2c, 0x63, 0x05,
-	0xf9, 0x8a, 0x5f, 0x69, 0x27, 0x12, 0x99, 0xd4, 0x99, 0xfa, 0x05, 0x3c, 0x87, 0xe2, 0xa6, 0xb7,
-	0x1f, 0xec, 0xc5, 0x24, 0xc8, 0x7b, 0xc2, 0x4b, 0x38, 0x51, 0xd4, 0x92, 0xd9, 0x92, 0x13, 0xa9,
-	0x4c, 0xea, 0x99, 0xfa, 0x63, 0xbc, 0x02, 0x78, 0x66, 0x6f, 0xba, 0xdb, 0xce, 0xb6, 0xef, 0x22,
-	0x0b, 0x7d, 0x47, 0xca, 0xfc, 0x7b, 0x02, 0xf9, 0x72, 0x1c, 0xeb, 0x1f, 0x4f, 0x01, 0xd3, 0x3b,
-	0x47, 0xda, 0x5b, 0x17, 0x4c, 0x67, 0xea, 0x80, 0x63, 0xe5, 0x51, 0x3b, 0x62, 0x3f, 0x88, 0x54,
-	0xa6, 0xf5, 0xa9, 0x3a, 0x20, 0x4a, 0x28, 0xef, 0x75, 0xbf, 0xb1, 0xce, 0xaf, 0x4d, 0x4f, 0x7b,
-	0xd3, 0x63, 0x09, 0x1f, 0xa0, 0x8a, 0xc4, 0x1e, 0x44, 0x2e, 0xd3, 0xba, 0x5c, 0x5c, 0x34, 0xe3,
-	0xdf, 0x34, 0x91, 0x1b, 0x2a, 0xda, 0x86, 0x0b, 0xa8, 0x96, 0xbb, 0xc8, 0x73, 0x45, 0x98, 0x2b,
-	0x5a, 0x43, 0x84, 0xec, 0xc9, 0xbc, 0xb1, 0x98, 0x86, 0x54, 0xe1, 0xfc, 0x52, 0x84, 0xd5, 0x5c,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x8e, 0x2c, 0x01, 0xa9, 0x01, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x5f, 0x4f, 0xd4, 0x4c,
+	0x14, 0xc6, 0xb7, 0xdb, 0x76, 0xff, 0x9c, 0x5d, 0xc8, 0x66, 0x5e, 0x5e, 0x1c, 0x31, 0x9a, 0x5a,
+	0x6e, 0x1a, 0x43, 0x30, 0xae, 0xf7, 0x26, 0x45, 0x0a, 0xbb, 0x61, 0x85, 0xcd, 0xb4, 0x70, 0xa9,
+	0x96, 0xed, 0x2c, 0x36, 0x94, 0x19, 0x32, 0x1d, 0x10, 0x12, 0x3f, 0x81, 0xdf, 0xc5, 0xef, 0x68,
+	0x3a, 0x6d, 0x77, 0x8b, 0x74, 0x35, 0x31, 0xf1, 0xae, 0xcf, 0x39, 0xcf, 0x33, 0xa7, 0x73, 0xfa,
+	0x4b, 0xa1, 0x47, 0x6f, 0x29, 0x93, 0xbb, 0xd7, 0x82, 0x4b, 0x8e, 0x8c, 0xaf, 0xb1, 0xa0, 0xf6,
+	0x3b, 0xe8, 0x04, 0x22, 0x64, 0xe9, 0x9c, 0x0a, 0xb4, 0x05, 0x1d, 0x42, 0x67, 0x34, 0xbe, 0xa5,
+	0x02, 0x6b, 0x96, 0xe6, 0x74, 0xc9, 0x42, 0xa3, 0x4d, 0x68, 0xb9, 0x57, 0xfc, 0x86, 0x49, 0xdc,
+	0xb4, 0x34, 0xc7, 0x20, 0x85, 0xb2, 0x3f, 0x42, 0x67, 0x9f, 0x26, 0xf4, 0x22, 0x94, 0xf4, 0x6f,
+	0xf2, 0xe8, 0x05, 0xc0, 0x29, 0x93, 0x71, 0xb2, 0x97, 0xf0, 0xd9, 0x25, 0xd6, 0x55, 0xaf, 0x52,
+	0xb1, 0x3f, 0x67, 0xfd, 0xe8, 0x5f, 0x4e, 0xb8, 0x03, 0x20, 0x74, 0x31, 0x61, 0x13, 0x5a, 0x3e,
+	0xbf, 0x11, 0x33, 0x5a, 0x9c, 0x5f, 0xa8, 0x07, 0x93, 0x9b, 0x2b, 0x27, 0xeb, 0xbf, 0x99, 0x6c,
+	0x3c, 0x9a, 0xbc, 0x0d, 0xa6, 0x9f, 0x84, 0xe9, 0x97, 0xec, 0xf0, 0x93, 0xf9, 0x9c, 0xb2, 0x68,
+	0x79, 0xad, 0x52, 0xdb, 0xaf, 0xe1, 0xff, 0xb3, 0x30, 0x89, 0xa3, 0x50, 0x72, 0x41, 0xe8, 0x45,
+	0x9c, 0x4a, 0x11, 0xca, 0x98, 0xb3, 0x6c, 0xea, 0xf4, 0xe6, 0xfc, 0x88, 0xde, 0xab, 0x48, 0x9f,
+	0x14, 0xca, 0xfe, 0x61, 0xc0, 0x7f, 0x63, 0x26, 0xa9, 0x60, 0x61, 0xa2, 0x3e, 0x6d, 0x38, 0x53,
+	0xfe, 0x0d, 0x30, 0xc7, 0x2c, 0xa2, 0x77, 0xca, 0x6e, 0x90, 0x5c, 0xac, 0xdc, 0x5a, 0xf5, 0xbe,
+	0xfa, 0x2f, 0xf7, 0xfd, 0xc3, 0xbd, 0xd0, 0x1b, 0x30, 0x8e, 0x62, 0x16, 0x61, 0xd3, 0xd2, 0x9c,
+	0xf5, 0xe1, 0xf3, 0xdd, 0x0c, 0xb4, 0xdd, 0x9a, 0x57, 0xca, 0x4c, 0x44, 0x59, 0x2b, 0x6b, 0x6f,
+	0x3d, 0x58, 0x3b, 0x86, 0xf6, 0x19, 0x15, 0x69, 0xcc, 0x19, 0x6e, 0x5b, 0x9a, 0xb3, 0x46, 0x4a,
+	0x89, 0x76, 0x96, 0xe0, 0xe2, 0x8e, 0xa5, 0x39, 0xbd, 0xe1, 0x7a, 0x3e, 0xa8, 0xac, 0x8e, 0x1a,
+	0x64, 0x89, 0xf6, 0xce, 0x12, 0x53, 0xdc, 0xad, 0xba, 0xcb, 0x6a, 0xe6, 0x2e, 0x9f, 0xd1, 0xb0,
+	0x0a, 0x1d, 0x06, 0xe5, 0x1f, 0xe4, 0xfe, 0x65, 0x7d, 0xd4, 0x20, 0x55, 0x34, 0x87, 0x55, 0x8c,
+	0x70, 0xaf, 0x9a, 0x21, 0xb4, 0x9a, 0x59, 0x2a, 0x54, 0x02, 0x80, 0xfb, 0xca, 0xde, 0xcb, 0xed,
+	0xaa, 0x34, 0x6a, 0x90, 0xbc, 0x87, 0xfc, 0x15, 0x00, 0xe0, 0x35, 0x15, 0x7a, 0x96, 0x87, 0x6a,
+	0x2d, 0xa3, 0x06, 0xa9, 0xcf, 0xee, 0x75, 0xa1, 0x3d, 0x0d, 0xef, 0x13, 0x1e, 0x46, 0xf6, 0xf7,
+	0x26, 0x98, 0x5e, 0xf6, 0x5f, 0x58, 0x41, 0x08, 0x86, 0xf6, 0x7b, 0x41, 0xb3, 0x13, 0x0a, 0xf0,
+	0x4b, 0x99, 0x75, 0xa6, 0xa1, 0xa0, 0x4c, 0xa6, 0x58, 0xb7, 0x74, 0xa7, 0x4f, 0x4a, 0x89, 0x2c,
+	0xe8, 0x4d, 0xc2, 0xab, 0x6b, 0x2e, 0x64, 0x10, 0x5f, 0xd1, 0x02, 0x91, 0x6a, 0x09, 0x7d, 0x80,
+	0x8d, 0x1a, 0x22, 0x52, 0x6c, 0x5a, 0xba, 0xd3, 0x1b, 0x3e, 0x5d, 0xc9, 0x0c, 0xa9, 0x8d, 0xa1,
+	0x21, 0x6c, 0x78, 0x77, 0x35, 0xc7, 0xb5, 0xd4, 0x7b, 0xd5, 0xf6, 0x10, 0x02, 0xc3, 0x8f, 0x2f,
+	0x72, 0xb0, 0xba, 0x44, 0x3d, 0xdb, 0x3e, 0x74, 0x0f, 0xb8, 0xb8, 0x9c, 0x0a, 0xce, 0xe7, 0xe8,
+	0x25, 0x98, 0x07, 0xb1, 0x48, 0x25, 0xd6, 0xaa, 0x9f, 0x47, 0xed, 0x8a, 0xe4, 0x1d, 0xb4, 0x0d,
+	0x2d, 0x9f, 0xce, 0x38, 0x8b, 0x70, 0xf3, 0xb1, 0xa7, 0x68, 0xbd, 0xfa, 0x06, 0x4f, 0x56, 0xd0,
+	0x8f, 0xfa, 0xd0, 0x09, 0x88, 0x7b, 0xec, 0x1f, 0x78, 0x64, 0xd0, 0x40, 0x5d, 0x30, 0xfd, 0x89,
+	0xeb, 0x8f, 0x06, 0x1a, 0x5a, 0x07, 0x38, 0x3d, 0xde, 0xf7, 0x26, 0xde, 0xa1, 0x1b, 0x78, 0x83,
+	0x66, 0xa6, 0x89, 0xb7, 0xd0, 0x7a, 0x16, 0x5c, 0x28, 0x03, 0x6d, 0xc1, 0xe6, 0x99, 0x3b, 0x19,
+	0xef, 0xbb, 0xc1, 0x09, 0xf9, 0x44, 0xbc, 0xc3, 0xb1, 0x1f, 0x10, 0x37, 0x18, 0x9f, 0x1c, 0x0f,
+	0xcc, 0xf3, 0x96, 0xfa, 0xdd, 0xbf, 0xfd, 0x39, 0x00, 0x09, 0x6b, 0xab, 0xd6, 0xfd, 0x05, 0x00,
+	0x00,
 }
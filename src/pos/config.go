@@ -1,20 +1,62 @@
 package pos
 
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// SPV is an address of "Special Purpose Vehicle" account,
+// which collects transaction fees and pays all rewards.
+var SPV = hash.Peer(hash.Of([]byte("Special Purpose Vehicle")))
+
 // Config for a PoS
 type Config struct {
 	TotalSupply uint64 `mapstructure:"total-supply"`
+	// TxFee is a fee of each internal transaction.
+	TxFee uint64 `mapstructure:"tx-fee"`
+	// BlockReward is paid from SPV to the creators of block events.
+	// No tokens are issued: rewards redistribute collected fees and fines only,
+	// so reward is limited by SPV balance.
+	BlockReward uint64 `mapstructure:"block-reward"`
+	// InvalidTxFine is charged for each invalid transaction.
+	InvalidTxFine uint64 `mapstructure:"invalid-tx-fine"`
+	// SlashPercent is a part of cheater's stake and of stakes delegated to cheater
+	// taken by slashing.
+	SlashPercent uint64 `mapstructure:"slash-percent"`
+	// EpochLen is a count of frames in epoch. Validators set is sealed at the epoch start.
+	EpochLen uint64 `mapstructure:"epoch-len"`
 }
 
 // NewConfig creates a new PoS config
 func NewConfig(totalSupply uint64) *Config {
 	return &Config{
-		TotalSupply: totalSupply,
+		TotalSupply:   totalSupply,
+		TxFee:         1,
+		BlockReward:   10,
+		InvalidTxFine: 10,
+		SlashPercent:  50,
+		EpochLen:      100,
+	}
+}
+
+// Hash returns hash of params which consensus depends on.
+func (c *Config) Hash() hash.Hash {
+	var buf bytes.Buffer
+	for _, v := range []uint64{
+		c.TxFee,
+		c.BlockReward,
+		c.InvalidTxFine,
+		c.SlashPercent,
+		c.EpochLen,
+	} {
+		_ = binary.Write(&buf, binary.BigEndian, v)
 	}
+	return hash.Of(buf.Bytes())
 }
 
 // DefaultConfig sets the default config for a PoS
 func DefaultConfig() *Config {
-	return &Config{
-		TotalSupply: 1000000000000000,
-	}
+	return NewConfig(1000000000000000)
 }
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// Block returns information about blocks.
+var Block = &cobra.Command{
+	Use:   "block",
+	Short: "Block returns information about blocks",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		proxy, err := makeCtrlProxy(cmd)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		for _, arg := range args {
+			n, err := strconv.ParseUint(arg, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			b, err := proxy.GetBlock(n)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("block %d of frame %d: atropos %s, time %d, state %s, %d events\n",
+				b.Index,
+				b.Frame,
+				b.Atropos.Hex(),
+				b.Time,
+				b.Root.Hex(),
+				len(b.Events),
+			)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	initCtrlProxy(Block)
+}
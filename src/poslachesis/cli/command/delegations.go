@@ -0,0 +1,54 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+)
+
+// Delegations prints incoming and outgoing delegations of a peer.
+var Delegations = &cobra.Command{
+	Use:   "delegations",
+	Short: "Prints incoming and outgoing delegations of a peer",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		proxy, err := makeCtrlProxy(cmd)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		var id hash.Peer
+		hex, err := cmd.Flags().GetString("peer")
+		if err != nil || hex == "self" {
+			id, err = proxy.GetSelfID()
+		} else {
+			id = hash.HexToPeer(hex)
+		}
+		if err != nil {
+			return err
+		}
+
+		incoming, outgoing, err := proxy.GetDelegations(id)
+		if err != nil {
+			return err
+		}
+
+		list := func(title string, dd []*state.Delegation) {
+			cmd.Printf("%s delegations of %s: %d\n", title, id.Hex(), len(dd))
+			for _, d := range dd {
+				cmd.Printf("  %s %d until block %d\n", d.Peer.Hex(), d.Amount, d.Until)
+			}
+		}
+		list("incoming", incoming)
+		list("outgoing", outgoing)
+
+		return nil
+	},
+}
+
+func init() {
+	initCtrlProxy(Delegations)
+
+	Delegations.Flags().String("peer", "self", "peer ID")
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// Redelegate makes a transaction to move delegation to another peer.
+var Redelegate = &cobra.Command{
+	Use:   "redelegate",
+	Short: "Moves delegation from one peer to another",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		amount, err := cmd.Flags().GetUint64("amount")
+		if err != nil {
+			return err
+		}
+		until, err := cmd.Flags().GetUint64("until")
+		if err != nil {
+			return err
+		}
+		index, err := cmd.Flags().GetUint64("index")
+		if err != nil {
+			return err
+		}
+		hex, err := cmd.Flags().GetString("from")
+		if err != nil {
+			return err
+		}
+		from := hash.HexToPeer(hex)
+		hex, err = cmd.Flags().GetString("to")
+		if err != nil {
+			return err
+		}
+		to := hash.HexToPeer(hex)
+
+		proxy, err := makeCtrlProxy(cmd)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		h, err := proxy.Redelegate(from, to, index, amount, until)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(h.Hex())
+		return nil
+	},
+}
+
+func init() {
+	initCtrlProxy(Redelegate)
+
+	Redelegate.Flags().String("from", "", "current delegation receiver (required)")
+	Redelegate.Flags().String("to", "", "new delegation receiver (required)")
+	Redelegate.Flags().Uint64("amount", 0, "delegation amount (required)")
+	Redelegate.Flags().Uint64("until", 0, "delegation expiration block (required)")
+	Redelegate.Flags().Uint64("index", 0, "transaction nonce (required)")
+
+	for _, f := range []string{"from", "to", "amount", "until", "index"} {
+		if err := Redelegate.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+}
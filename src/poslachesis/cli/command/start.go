@@ -48,9 +48,23 @@ var Start = &cobra.Command{
 		}
 
 		net, keys := lachesis.FakeNet(total)
+		if err := net.Poset.Validate(); err != nil {
+			return err
+		}
 		conf := lachesis.DefaultConfig()
 		conf.Net = net
 
+		conf.Node.Advertise, err = cmd.Flags().GetStringSlice("advertise")
+		if err != nil {
+			return err
+		}
+		conf.Node.Advertise = trim(conf.Node.Advertise)
+
+		conf.Node.TLS, err = cmd.Flags().GetBool("tls")
+		if err != nil {
+			return err
+		}
+
 		l := lachesis.New(db, "", keys[num], conf)
 		l.Start()
 		defer l.Stop()
@@ -77,6 +91,8 @@ func init() {
 	Start.Flags().String("fakegen", "1/1", "use N/T format to use N-th key from T genesis keys")
 	Start.Flags().String("db", "inmemory", "badger database dir")
 	Start.Flags().StringSlice("peer", nil, "hosts of peers")
+	Start.Flags().StringSlice("advertise", nil, "external host:port addresses of node")
+	Start.Flags().Bool("tls", false, "use mutual TLS for node connections")
 	Start.Flags().String("log", "info", "log level")
 	Start.Flags().String("dsn", "", "Sentry client DSN")
 }
@@ -110,7 +126,7 @@ func ondiskDB(dir string) (*badger.DB, error) {
 }
 
 func wait() {
-	done := make(chan os.Signal)
+	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, os.Kill)
 	<-done
 }
@@ -0,0 +1,61 @@
+package command
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// Undelegate makes a transaction to cancel delegation before expiration.
+var Undelegate = &cobra.Command{
+	Use:   "undelegate",
+	Short: "Cancels delegation to given peer before expiration",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		amount, err := cmd.Flags().GetUint64("amount")
+		if err != nil {
+			return err
+		}
+		until, err := cmd.Flags().GetUint64("until")
+		if err != nil {
+			return err
+		}
+		index, err := cmd.Flags().GetUint64("index")
+		if err != nil {
+			return err
+		}
+		hex, err := cmd.Flags().GetString("from")
+		if err != nil {
+			return err
+		}
+		from := hash.HexToPeer(hex)
+
+		proxy, err := makeCtrlProxy(cmd)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+
+		h, err := proxy.Undelegate(from, index, amount, until)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(h.Hex())
+		return nil
+	},
+}
+
+func init() {
+	initCtrlProxy(Undelegate)
+
+	Undelegate.Flags().String("from", "", "delegation receiver (required)")
+	Undelegate.Flags().Uint64("amount", 0, "delegation amount (required)")
+	Undelegate.Flags().Uint64("until", 0, "delegation expiration block (required)")
+	Undelegate.Flags().Uint64("index", 0, "transaction nonce (required)")
+
+	for _, f := range []string{"from", "amount", "until", "index"} {
+		if err := Undelegate.MarkFlagRequired(f); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -22,7 +22,11 @@ func prepareApp() *cobra.Command {
 	app.AddCommand(command.ID)
 	app.AddCommand(command.Balance)
 	app.AddCommand(command.Transfer)
+	app.AddCommand(command.Undelegate)
+	app.AddCommand(command.Redelegate)
+	app.AddCommand(command.Delegations)
 	app.AddCommand(command.Info)
+	app.AddCommand(command.Block)
 	app.AddCommand(command.LogLevel)
 
 	return &app
@@ -11,7 +11,9 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 	"github.com/Fantom-foundation/go-lachesis/src/proxy"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 //go:generate mockgen -package=main -destination=mock_test.go github.com/Fantom-foundation/go-lachesis/src/proxy Node,Consensus
@@ -160,6 +162,43 @@ func TestApp(t *testing.T) {
 		)
 	})
 
+	t.Run("block", func(t *testing.T) {
+		assert := assert.New(t)
+
+		b := posposet.Block{
+			Index:   1,
+			Frame:   2,
+			Atropos: hash.FakeEvent(),
+			Time:    3,
+			Root:    hash.FakeHash(),
+			Events:  hash.FakeEvents(4).Slice(),
+		}
+
+		consensus.EXPECT().
+			GetBlock(b.Index).
+			Return(&b)
+
+		app.SetArgs([]string{
+			"block",
+			"1",
+		})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Contains(
+			out.String(),
+			fmt.Sprintf(
+				"block 1 of frame 2: atropos %s, time 3, state %s, 4 events",
+				b.Atropos.Hex(),
+				b.Root.Hex(),
+			),
+		)
+	})
+
 	t.Run("transfer missing flags", func(t *testing.T) {
 		assert := assert.New(t)
 
@@ -205,6 +244,101 @@ func TestApp(t *testing.T) {
 		assert.Contains(out.String(), h.Hex())
 	})
 
+	t.Run("undelegate", func(t *testing.T) {
+		assert := assert.New(t)
+
+		h := hash.FakeTransaction()
+		tx := inter.InternalTransaction{
+			Index:      2,
+			Amount:     10,
+			Receiver:   peer,
+			UntilBlock: 100,
+			Kind:       inter.UndelegateTx,
+			Version:    inter.InternalTxVersion,
+		}
+
+		node.EXPECT().
+			AddInternalTxn(tx).
+			Return(h, nil)
+
+		app.SetArgs([]string{
+			"undelegate",
+			fmt.Sprintf("--index=%d", tx.Index),
+			fmt.Sprintf("--amount=%d", tx.Amount),
+			fmt.Sprintf("--until=%d", tx.UntilBlock),
+			fmt.Sprintf("--from=%s", tx.Receiver.Hex())})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Contains(out.String(), h.Hex())
+	})
+
+	t.Run("redelegate", func(t *testing.T) {
+		assert := assert.New(t)
+
+		h := hash.FakeTransaction()
+		tx := inter.InternalTransaction{
+			Index:      3,
+			Amount:     10,
+			Receiver:   hash.FakePeer(),
+			UntilBlock: 100,
+			Kind:       inter.RedelegateTx,
+			Source:     peer,
+			Version:    inter.InternalTxVersion,
+		}
+
+		node.EXPECT().
+			AddInternalTxn(tx).
+			Return(h, nil)
+
+		app.SetArgs([]string{
+			"redelegate",
+			fmt.Sprintf("--index=%d", tx.Index),
+			fmt.Sprintf("--amount=%d", tx.Amount),
+			fmt.Sprintf("--until=%d", tx.UntilBlock),
+			fmt.Sprintf("--from=%s", tx.Source.Hex()),
+			fmt.Sprintf("--to=%s", tx.Receiver.Hex())})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Contains(out.String(), h.Hex())
+	})
+
+	t.Run("delegations", func(t *testing.T) {
+		assert := assert.New(t)
+
+		otherPeer := hash.FakePeer()
+		incoming := []*state.Delegation{
+			{Peer: otherPeer, Amount: 5, Until: 100},
+		}
+
+		consensus.EXPECT().
+			GetDelegations(peer).
+			Return(incoming, nil)
+
+		app.SetArgs([]string{
+			"delegations",
+			fmt.Sprintf("--peer=%s", peer.Hex())})
+		defer out.Reset()
+
+		err := app.Execute()
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Contains(out.String(), fmt.Sprintf("incoming delegations of %s: 1", peer.Hex()))
+		assert.Contains(out.String(), fmt.Sprintf("%s 5 until block 100", otherPeer.Hex()))
+		assert.Contains(out.String(), fmt.Sprintf("outgoing delegations of %s: 0", peer.Hex()))
+	})
+
 	t.Run("log-level one argument", func(t *testing.T) {
 		assert := assert.New(t)
 
@@ -4,12 +4,16 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/common"
 	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 )
 
 // Net describes lachesis net.
 type Net struct {
 	Name    string
 	Genesis map[hash.Peer]uint64
+	PoS     *pos.Config
+	Poset   *posposet.Config
 }
 
 // FakeNet generates fake net with n-nodes genesis.
@@ -25,6 +29,8 @@ func FakeNet(n int) (*Net, []*common.PrivateKey) {
 	return &Net{
 		Name:    "fake",
 		Genesis: genesis,
+		PoS:     pos.DefaultConfig(),
+		Poset:   posposet.DefaultConfig(),
 	}, keys
 }
 
@@ -35,6 +41,8 @@ func MainNet() *Net {
 		Genesis: map[hash.Peer]uint64{
 			// TODO: fill with official keys and balances.
 		},
+		PoS:   pos.DefaultConfig(),
+		Poset: posposet.DefaultConfig(),
 	}
 }
 
@@ -45,5 +53,7 @@ func TestNet() *Net {
 		Genesis: map[hash.Peer]uint64{
 			// TODO: fill with official keys and balances.
 		},
+		PoS:   pos.DefaultConfig(),
+		Poset: posposet.DefaultConfig(),
 	}
 }
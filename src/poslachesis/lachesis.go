@@ -39,7 +39,7 @@ func makeLachesis(db *badger.DB, host string, key *common.PrivateKey, conf *Conf
 		conf = DefaultConfig()
 	}
 
-	c := posposet.New(cdb, ndb)
+	c := posposet.New(cdb, ndb, conf.Net.PoS, conf.Net.Poset)
 	n := posnode.New(host, key, ndb, c, &conf.Node, listen, opts...)
 
 	return &Lachesis{
@@ -78,6 +78,12 @@ func (l *Lachesis) AddPeers(hosts ...string) {
 }
 
 func (l *Lachesis) init() {
+	if params := l.conf.Net.Poset; params != nil {
+		if err := params.Validate(); err != nil {
+			l.Fatal(err)
+		}
+	}
+
 	genesis := l.conf.Net.Genesis
 	err := l.consensusStore.ApplyGenesis(genesis)
 	if err != nil {
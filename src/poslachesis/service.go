@@ -34,7 +34,10 @@ func (l *Lachesis) serviceStart() {
 		<-done
 	}(l.service.done)
 
+	blocks := l.consensus.Subscribe(posposet.BlockDecided, 100, posposet.BlockOnSlow)
 	go func(done chan struct{}) {
+		defer blocks.Unsubscribe()
+
 		app, _, err := proxy.NewGrpcAppProxy(
 			l.AppListenAddr(),
 			l.conf.Node.ClientTimeout,
@@ -46,16 +49,17 @@ func (l *Lachesis) serviceStart() {
 		}
 		defer app.Close()
 
-		l.consensus.NewBlockCh = make(chan uint64, 100)
-
 		for {
 			select {
 			case tx := <-app.SubmitCh():
 				l.node.AddExternalTxn(tx)
 			case tx := <-app.SubmitInternalCh():
 				l.node.AddInternalTxn(tx)
-			case num := <-l.consensus.NewBlockCh:
-				b := l.consensusStore.GetBlock(num)
+			case n, ok := <-blocks.C:
+				if !ok {
+					return
+				}
+				b := l.consensusStore.GetBlock(n.Block)
 				block := l.toLegacyBlock(b)
 				_, _ = app.CommitBlock(*block)
 			case <-done:
@@ -80,11 +84,14 @@ func (l *Lachesis) toLegacyBlock(b *posposet.Block) *poset.Block {
 		event := l.nodeStore.GetEvent(e)
 		txns = append(txns, event.ExternalTransactions...)
 	}
-	// NOTE: Signatures and Hashes are empty
+	// NOTE: Signatures and Hashes are empty, RoundReceived, FrameHash and StateHash too:
+	// legacy rounds and hashes have no posposet analogue, frame, Atropos and state root
+	// are available by ctrl BlockInfo.
 	return &poset.Block{
 		Body: &poset.BlockBody{
 			Index:        int64(b.Index),
 			Transactions: txns,
 		},
+		CreatedTime: int64(b.Time),
 	}
 }
@@ -0,0 +1,173 @@
+package posnode
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// advertising is a self-certified record of node endpoints.
+type advertising struct {
+	addrs   *PeerAddrs
+	changed bool
+
+	sync.RWMutex
+}
+
+// Advertise makes new signed record of node endpoints:
+// listen address and external "host:port" addresses.
+// Known peers get it at the next discovery round.
+func (n *Node) Advertise(external ...string) error {
+	now := time.Unix(0, time.Now().UnixNano())
+
+	list := make([]PeerAddr, 0, len(external)+1)
+	if n.host != "" {
+		list = append(list, PeerAddr{
+			Host: n.host,
+			Port: n.conf.Port,
+			Time: now,
+		})
+	}
+	for _, addr := range external {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return err
+		}
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid port of %s: %s", addr, err)
+		}
+		list = append(list, PeerAddr{
+			Host:       host,
+			Port:       int(p),
+			Time:       now,
+			Advertised: true,
+		})
+	}
+
+	n.advertising.Lock()
+	defer n.advertising.Unlock()
+
+	record := &PeerAddrs{
+		List: list,
+		Time: now,
+	}
+	if prev := n.advertising.addrs; prev != nil {
+		// keep time of unchanged endpoints
+		for i, a := range record.List {
+			for _, b := range prev.List {
+				if a.Host == b.Host && a.Port == b.Port && a.Advertised == b.Advertised {
+					record.List[i].Time = b.Time
+				}
+			}
+		}
+		if !record.Time.After(prev.Time) {
+			record.Time = prev.Time.Add(time.Nanosecond)
+		}
+	}
+
+	if err := record.SignBy(n.key); err != nil {
+		return err
+	}
+
+	n.advertising.addrs = record
+	n.advertising.changed = true
+	return nil
+}
+
+// selfAddrs returns signed record of node endpoints.
+func (n *Node) selfAddrs() *PeerAddrs {
+	n.advertising.RLock()
+	addrs := n.advertising.addrs
+	n.advertising.RUnlock()
+
+	if addrs != nil {
+		return addrs
+	}
+
+	if err := n.Advertise(n.conf.Advertise...); err != nil {
+		n.Warnf("invalid advertised addresses: %s", err)
+		if err = n.Advertise(); err != nil {
+			n.Fatal(err)
+		}
+	}
+
+	n.advertising.RLock()
+	defer n.advertising.RUnlock()
+	return n.advertising.addrs
+}
+
+// spreadAddrs pings the closest known peers to deliver changed self record.
+func (n *Node) spreadAddrs() {
+	n.advertising.Lock()
+	changed := n.advertising.changed
+	n.advertising.changed = false
+	n.advertising.Unlock()
+
+	if !changed {
+		return
+	}
+
+	for _, id := range n.closestPeers(n.ID, n.conf.BucketSize) {
+		peer := n.store.GetPeer(id)
+		if peer == nil {
+			continue
+		}
+		if _, err := n.pingPeer(peer); err != nil {
+			n.Warnf("spread addresses to %s: %s", id.String(), err)
+		}
+	}
+}
+
+// checkPeerInfo returns error if peer info is not self-certified.
+func checkPeerInfo(info *api.PeerInfo) error {
+	id := hash.HexToPeer(info.ID)
+	if hash.PeerOfPubkeyBytes(info.PubKey) != id {
+		return fmt.Errorf("peer %s info has alien public key", id.String())
+	}
+
+	if info.Addrs != nil {
+		addrs := WireToPeerAddrs(info.Addrs)
+		if !addrs.Verify(common.BytesToPubkey(info.PubKey)) {
+			return fmt.Errorf("peer %s addresses are not signed", id.String())
+		}
+	}
+
+	return nil
+}
+
+// savePeerInfo stores checked peer info if it is new or has newer addresses record.
+func (n *Node) savePeerInfo(info *api.PeerInfo) {
+	id := hash.HexToPeer(info.ID)
+
+	stored := n.store.GetWirePeer(id)
+	if stored != nil && !newerAddrs(info.Addrs, stored.Addrs) {
+		if stored.Host == info.Host {
+			return
+		}
+		info.Addrs = stored.Addrs
+	}
+
+	n.store.SetWirePeer(id, info)
+}
+
+/*
+ * Utils:
+ */
+
+// newerAddrs returns true if a is newer than b.
+func newerAddrs(a, b *api.PeerAddrs) bool {
+	if a == nil {
+		return false
+	}
+	if b == nil {
+		return true
+	}
+	return a.Time > b.Time
+}
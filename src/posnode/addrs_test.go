@@ -0,0 +1,95 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+)
+
+func TestPeerAddrs(t *testing.T) {
+	node := NewForTests("node1", NewMemStore(), nil)
+
+	t.Run("self-certified", func(t *testing.T) {
+		assert := assert.New(t)
+
+		info := node.AsPeer().ToWire()
+		assert.NoError(checkPeerInfo(info))
+
+		info.Addrs.List[0].Port++
+		assert.Error(checkPeerInfo(info), "tampered")
+
+		other := node.selfAddrs()
+		if !assert.NoError(other.SignBy(crypto.GenerateKey())) {
+			return
+		}
+		info.Addrs = other.ToWire()
+		assert.Error(checkPeerInfo(info), "alien sign")
+	})
+
+	t.Run("advertise", func(t *testing.T) {
+		assert := assert.New(t)
+
+		prev := node.selfAddrs()
+		assert.Error(node.Advertise("no-port"))
+		if !assert.NoError(node.Advertise("1.2.3.4:5678")) {
+			return
+		}
+		curr := node.selfAddrs()
+
+		assert.True(curr.Time.After(prev.Time))
+		assert.Len(curr.List, 2)
+		assert.Equal(prev.List[0].Time, curr.List[0].Time, "unchanged endpoint keeps time")
+		assert.True(curr.Verify(node.pub))
+
+		peer := node.AsPeer()
+		assert.Equal("1.2.3.4:5678", peer.NetAddr(node.conf.Port), "advertised is preferred")
+		peer.Addrs = nil
+		assert.Equal(node.NetAddrOf(node.host), peer.NetAddr(node.conf.Port))
+	})
+
+	t.Run("newer only", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := FakePeer("peer")
+		old := peer.ToWire()
+		old.Addrs = (&PeerAddrs{}).ToWire()
+
+		curr := peer.ToWire()
+		curr.Addrs = (&PeerAddrs{Time: node.selfAddrs().Time}).ToWire()
+
+		node.savePeerInfo(curr)
+		node.savePeerInfo(old)
+		assert.Equal(curr.Addrs.Time, node.store.GetWirePeer(peer.ID).Addrs.Time)
+	})
+}
+
+func TestPeerAddrsSpreading(t *testing.T) {
+	assert := assert.New(t)
+
+	node1 := NewForTests("addrs1", NewMemStore(), nil)
+	node1.initPeers()
+	node1.StartService()
+	defer node1.StopService()
+
+	node2 := NewForTests("addrs2", NewMemStore(), nil)
+	node2.initPeers()
+	node2.StartService()
+	defer node2.StopService()
+
+	// exchange records
+	_, err := node2.PingHost(node1.Host())
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(node1.AsPeer(), node2.store.GetPeer(node1.ID))
+	assert.Equal(node2.AsPeer(), node1.store.GetPeer(node2.ID))
+
+	// refresh
+	if !assert.NoError(node1.Advertise(node1.NetAddrOf(node1.Host()), "10.0.0.1:1234")) {
+		return
+	}
+	node1.spreadAddrs()
+	assert.Equal(node1.AsPeer(), node2.store.GetPeer(node1.ID))
+}
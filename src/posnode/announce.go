@@ -0,0 +1,245 @@
+package posnode
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+const (
+	announceBatch    = 100
+	announceFetchers = 4 // count of goroutines downloading announced events
+)
+
+type (
+	// announcement is a push gossip process.
+	announcement struct {
+		hashes  chan hash.Event
+		fetches chan announceTask
+		done    chan struct{}
+
+		sync.RWMutex
+	}
+
+	// announceTask is a task to download announced events from source.
+	announceTask struct {
+		source hash.Peer
+		events hash.Events
+	}
+)
+
+// StartAnnouncement starts push gossip: announcing new events to the random
+// top peers and downloading unknown events announced by others.
+// Pushes and fetches are processed by separated goroutines,
+// so slow peer does not stall the others.
+func (n *Node) StartAnnouncement() {
+	n.announcement.Lock()
+	defer n.announcement.Unlock()
+
+	if n.announcement.done != nil {
+		return
+	}
+
+	n.initClient()
+	n.initPeers()
+
+	hashes := make(chan hash.Event, announceBatch*10)
+	fetches := make(chan announceTask, n.conf.TopPeersCount)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case h := <-hashes:
+				n.pushAnnouncement(collectAnnounces(h, hashes))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < announceFetchers; i++ {
+		go func() {
+			for {
+				select {
+				case task := <-fetches:
+					n.fetchAnnounced(task)
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	n.announcement.hashes = hashes
+	n.announcement.fetches = fetches
+	n.announcement.done = done
+
+	n.Info("announcement started")
+}
+
+// StopAnnouncement stops push gossip.
+func (n *Node) StopAnnouncement() {
+	n.announcement.Lock()
+	defer n.announcement.Unlock()
+
+	if n.announcement.done == nil {
+		return
+	}
+
+	close(n.announcement.done)
+	n.announcement.done = nil
+	n.announcement.hashes = nil
+	n.announcement.fetches = nil
+
+	n.Info("announcement stopped")
+}
+
+// announceEvent queues event hash for announcement.
+// Skipped event will be got by pull gossip.
+func (n *Node) announceEvent(e hash.Event) {
+	if n.conf.AnnounceFanout < 1 {
+		return
+	}
+
+	n.announcement.RLock()
+	defer n.announcement.RUnlock()
+
+	if n.announcement.hashes == nil {
+		return
+	}
+
+	select {
+	case n.announcement.hashes <- e:
+	default:
+		n.Debug("announcement.hashes queue is full, so skipped")
+	}
+}
+
+// collectAnnounces gets queued hashes into batch.
+func collectAnnounces(first hash.Event, hashes chan hash.Event) hash.Events {
+	batch := hash.NewEvents(first)
+	for len(batch) < announceBatch {
+		select {
+		case h := <-hashes:
+			batch.Add(h)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// pushAnnouncement announces events to fan-out random top peers.
+func (n *Node) pushAnnouncement(events hash.Events) {
+	top := n.peers.Snapshot()
+	rand.Shuffle(len(top), func(i, j int) {
+		top[i], top[j] = top[j], top[i]
+	})
+	if len(top) > n.conf.AnnounceFanout {
+		top = top[:n.conf.AnnounceFanout]
+	}
+
+	req := &api.EventHashes{
+		Hashes: make([][]byte, 0, len(events)),
+	}
+	for e := range events {
+		req.Hashes = append(req.Hashes, e.Bytes())
+	}
+
+	for _, id := range top {
+		peer := n.store.GetPeer(id)
+		if peer == nil {
+			continue
+		}
+		n.announceTo(peer, req)
+	}
+}
+
+func (n *Node) announceTo(peer *Peer, req *api.EventHashes) {
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		return
+	}
+	defer free()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	if _, err = client.AnnounceEvents(ctx, req); err != nil {
+		fail(err)
+		n.ConnectFail(peer, err)
+		return
+	}
+
+	n.ConnectOK(peer)
+}
+
+// fetchAnnounced downloads unknown announced events and their parents.
+func (n *Node) fetchAnnounced(task announceTask) {
+	peer := n.store.GetPeer(task.source)
+	if peer == nil {
+		return
+	}
+
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		return
+	}
+	defer free()
+
+	toDownload := n.lockNotDownloaded(task.events)
+	defer n.unlockDownloaded(toDownload)
+
+	parents := hash.Events{}
+	for e := range toDownload {
+		req := &api.EventRequest{
+			Hash: e.Bytes(),
+		}
+		event, err := n.downloadEvent(client, peer, req)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if event == nil {
+			continue
+		}
+		parents.Add(event.Parents.Slice()...)
+	}
+	n.ConnectOK(peer)
+
+	n.checkParents(client, peer, parents)
+}
+
+// onAnnounce returns unknown events from announcement and queues their downloading.
+func (n *Node) onAnnounce(source hash.Peer, req *api.EventHashes) hash.Events {
+	unknowns := hash.Events{}
+	for _, buf := range req.Hashes {
+		var e hash.Event
+		e.SetBytes(buf)
+		if n.store.GetEvent(e) == nil {
+			unknowns.Add(e)
+		}
+	}
+	if len(unknowns) < 1 {
+		return unknowns
+	}
+
+	n.announcement.RLock()
+	defer n.announcement.RUnlock()
+
+	if n.announcement.fetches == nil {
+		return unknowns
+	}
+
+	select {
+	case n.announcement.fetches <- announceTask{source, unknowns}:
+	default:
+		n.Debug("announcement.fetches queue is full, so skipped")
+	}
+
+	return unknowns
+}
@@ -0,0 +1,51 @@
+package posnode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnouncement(t *testing.T) {
+	// node 1
+	store1 := NewMemStore()
+	node1 := NewForTests("node1", store1, nil)
+	node1.conf.AnnounceFanout = 1
+	node1.StartService()
+	defer node1.StopService()
+
+	// node 2
+	store2 := NewMemStore()
+	node2 := NewForTests("node2", store2, nil)
+	node2.StartService()
+	defer node2.StopService()
+
+	// connect nodes to each other
+	store1.BootstrapPeers(node2.AsPeer())
+	store2.BootstrapPeers(node1.AsPeer())
+
+	node1.StartAnnouncement()
+	defer node1.StopAnnouncement()
+	node2.StartAnnouncement()
+	defer node2.StopAnnouncement()
+
+	t.Run("push", func(t *testing.T) {
+		assert := assert.New(t)
+
+		e := node1.EmitEvent()
+
+		for i := 0; i < 500 && store2.GetEvent(e.Hash()) == nil; i++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.NotNil(store2.GetEvent(e.Hash()), "node2 got announced event")
+	})
+
+	t.Run("pull only", func(t *testing.T) {
+		assert := assert.New(t)
+
+		node2.EmitEvent()
+
+		assert.Empty(node2.announcement.hashes, "node2 does not announce")
+	})
+}
@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// limitedPeers is a max count of peers with remembered quotas.
+const limitedPeers = 10000
+
+type (
+	// Limits is a set of service quotas. Zero value means unlimited.
+	Limits struct {
+		PeerRate        float64 // requests per second of each peer
+		PeerBurst       int     // max requests at once of each peer
+		TotalRate       float64 // requests per second of all peers
+		TotalBurst      int     // max requests at once of all peers
+		PeerStreams     int     // max concurrent streams of each peer
+		TotalStreams    int     // max concurrent streams of all peers
+		MaxResponseSize int     // max size of response message, bytes
+	}
+
+	// Limiter throttles requests of authenticated peers by token buckets.
+	Limiter struct {
+		limits  Limits
+		total   *tokenBucket
+		streams int
+		peers   *lru.Cache
+
+		sync.Mutex
+	}
+
+	// peerQuota is a rest of peer's limits.
+	peerQuota struct {
+		bucket    *tokenBucket
+		streams   int
+		throttled uint64
+	}
+
+	// tokenBucket allows rate of events with bursts.
+	tokenBucket struct {
+		rate   float64
+		burst  float64
+		tokens float64
+		last   time.Time
+	}
+)
+
+// NewLimiter creates limiter of service requests.
+func NewLimiter(limits Limits) *Limiter {
+	cache, err := lru.New(limitedPeers)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Limiter{
+		limits: limits,
+		total:  newTokenBucket(limits.TotalRate, limits.TotalBurst),
+		peers:  cache,
+	}
+}
+
+// Throttled returns counters of rejected requests of each peer.
+func (l *Limiter) Throttled() map[hash.Peer]uint64 {
+	l.Lock()
+	defer l.Unlock()
+
+	res := make(map[hash.Peer]uint64)
+	for _, key := range l.peers.Keys() {
+		val, ok := l.peers.Peek(key)
+		if !ok {
+			continue
+		}
+		if q := val.(*peerQuota); q.throttled > 0 {
+			res[key.(hash.Peer)] = q.throttled
+		}
+	}
+	return res
+}
+
+// ServerLimit makes server-side interceptor for throttling.
+// It should follow identification interceptor.
+func (l *Limiter) ServerLimit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l == nil {
+			return handler(ctx, req)
+		}
+
+		if _, err := l.allow(peerOf(ctx), false); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ServerStreamLimit makes server-side stream interceptor for throttling.
+// It should follow identification interceptor.
+func (l *Limiter) ServerStreamLimit() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if l == nil {
+			return handler(srv, ss)
+		}
+
+		s := &limitedServerStream{
+			ServerStream: ss,
+			limiter:      l,
+		}
+		defer s.close()
+
+		return handler(srv, s)
+	}
+}
+
+// maxResponseSize returns max size of sent message.
+func (l *Limiter) maxResponseSize() int {
+	if l == nil || l.limits.MaxResponseSize < 1 {
+		return math.MaxInt32
+	}
+	return l.limits.MaxResponseSize
+}
+
+// allow takes request token (and stream slot) of peer or returns error.
+func (l *Limiter) allow(id hash.Peer, stream bool) (*peerQuota, error) {
+	l.Lock()
+	defer l.Unlock()
+
+	q := l.quotaOf(id)
+	now := time.Now()
+
+	if stream {
+		if l.limits.PeerStreams > 0 && q.streams >= l.limits.PeerStreams {
+			q.throttled++
+			return nil, status.Error(codes.ResourceExhausted, "too many streams of peer")
+		}
+		if l.limits.TotalStreams > 0 && l.streams >= l.limits.TotalStreams {
+			q.throttled++
+			return nil, status.Error(codes.ResourceExhausted, "too many streams")
+		}
+	}
+
+	if !q.bucket.take(now) {
+		q.throttled++
+		return nil, status.Error(codes.ResourceExhausted, "too many requests of peer")
+	}
+	if !l.total.take(now) {
+		q.throttled++
+		return nil, status.Error(codes.ResourceExhausted, "too many requests")
+	}
+
+	if stream {
+		q.streams++
+		l.streams++
+	}
+	return q, nil
+}
+
+// release frees stream slot of peer.
+func (l *Limiter) release(q *peerQuota) {
+	l.Lock()
+	defer l.Unlock()
+
+	q.streams--
+	l.streams--
+}
+
+func (l *Limiter) quotaOf(id hash.Peer) *peerQuota {
+	if val, ok := l.peers.Get(id); ok {
+		return val.(*peerQuota)
+	}
+
+	q := &peerQuota{
+		bucket: newTokenBucket(l.limits.PeerRate, l.limits.PeerBurst),
+	}
+	l.peers.Add(id, q)
+	return q
+}
+
+/*
+ * Utils:
+ */
+
+// limitedServerStream takes limits when client is identified by the first (request) message.
+type limitedServerStream struct {
+	grpc.ServerStream
+
+	limiter *Limiter
+	quota   *peerQuota
+}
+
+func (s *limitedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.quota == nil {
+		q, err := s.limiter.allow(peerOf(s.Context()), true)
+		if err != nil {
+			return err
+		}
+		s.quota = q
+	}
+
+	return nil
+}
+
+func (s *limitedServerStream) close() {
+	if s.quota != nil {
+		s.limiter.release(s.quota)
+		s.quota = nil
+	}
+}
+
+// peerOf returns identified client or empty id.
+func peerOf(ctx context.Context) hash.Peer {
+	id, _ := ctx.Value(peerID{}).(hash.Peer)
+	return id
+}
+
+// newTokenBucket makes bucket or nil if rate is unlimited.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+// take returns true if token is available.
+func (b *tokenBucket) take(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// chainUnaryServer makes interceptor which calls outer then inner.
+func chainUnaryServer(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamServer makes stream interceptor which calls outer then inner.
+func chainStreamServer(outer, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return outer(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestTokenBucket(t *testing.T) {
+	assert := assert.New(t)
+
+	b := newTokenBucket(10, 2)
+	now := time.Now()
+
+	assert.True(b.take(now))
+	assert.True(b.take(now))
+	assert.False(b.take(now), "burst is spent")
+
+	now = now.Add(100 * time.Millisecond)
+	assert.True(b.take(now), "refilled")
+	assert.False(b.take(now))
+
+	now = now.Add(time.Hour)
+	assert.True(b.take(now))
+	assert.True(b.take(now))
+	assert.False(b.take(now), "refilled up to burst only")
+
+	var unlimited *tokenBucket
+	assert.True(unlimited.take(now))
+}
+
+func TestLimiter(t *testing.T) {
+	peer1, peer2 := hash.FakePeer(), hash.FakePeer()
+
+	t.Run("requests", func(t *testing.T) {
+		assert := assert.New(t)
+
+		l := NewLimiter(Limits{
+			PeerRate:   0.001,
+			PeerBurst:  2,
+			TotalRate:  0.001,
+			TotalBurst: 3,
+		})
+
+		_, err := l.allow(peer1, false)
+		assert.NoError(err)
+		_, err = l.allow(peer1, false)
+		assert.NoError(err)
+		_, err = l.allow(peer1, false)
+		assert.Equal(codes.ResourceExhausted, status.Code(err), "peer limit")
+
+		_, err = l.allow(peer2, false)
+		assert.NoError(err)
+		_, err = l.allow(peer2, false)
+		assert.Equal(codes.ResourceExhausted, status.Code(err), "total limit")
+
+		assert.Equal(map[hash.Peer]uint64{peer1: 1, peer2: 1}, l.Throttled())
+	})
+
+	t.Run("streams", func(t *testing.T) {
+		assert := assert.New(t)
+
+		l := NewLimiter(Limits{
+			PeerStreams:  1,
+			TotalStreams: 2,
+		})
+
+		q1, err := l.allow(peer1, true)
+		assert.NoError(err)
+		_, err = l.allow(peer1, true)
+		assert.Equal(codes.ResourceExhausted, status.Code(err), "peer limit")
+
+		q2, err := l.allow(peer2, true)
+		assert.NoError(err)
+		_, err = l.allow(hash.FakePeer(), true)
+		assert.Equal(codes.ResourceExhausted, status.Code(err), "total limit")
+
+		_, err = l.allow(peer1, false)
+		assert.NoError(err, "unary requests are not limited by streams")
+
+		l.release(q1)
+		l.release(q2)
+		_, err = l.allow(peer1, true)
+		assert.NoError(err)
+	})
+}
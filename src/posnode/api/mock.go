@@ -9,6 +9,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	context "golang.org/x/net/context"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	reflect "reflect"
 )
 
@@ -75,6 +76,46 @@ func (mr *MockNodeClientMockRecorder) GetEvent(ctx, in interface{}, opts ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockNodeClient)(nil).GetEvent), varargs...)
 }
 
+// GetEvents mocks base method
+func (m *MockNodeClient) GetEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_GetEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEvents", varargs...)
+	ret0, _ := ret[0].(Node_GetEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockNodeClientMockRecorder) GetEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockNodeClient)(nil).GetEvents), varargs...)
+}
+
+// AnnounceEvents mocks base method
+func (m *MockNodeClient) AnnounceEvents(ctx context.Context, in *EventHashes, opts ...grpc.CallOption) (*EventHashes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnounceEvents", varargs...)
+	ret0, _ := ret[0].(*EventHashes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceEvents indicates an expected call of AnnounceEvents
+func (mr *MockNodeClientMockRecorder) AnnounceEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceEvents", reflect.TypeOf((*MockNodeClient)(nil).AnnounceEvents), varargs...)
+}
+
 // GetPeerInfo mocks base method
 func (m *MockNodeClient) GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerInfo, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +136,189 @@ func (mr *MockNodeClientMockRecorder) GetPeerInfo(ctx, in interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerInfo", reflect.TypeOf((*MockNodeClient)(nil).GetPeerInfo), varargs...)
 }
 
+// FindNode mocks base method
+func (m *MockNodeClient) FindNode(ctx context.Context, in *NodeLookup, opts ...grpc.CallOption) (*PeerInfos, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindNode", varargs...)
+	ret0, _ := ret[0].(*PeerInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNode indicates an expected call of FindNode
+func (mr *MockNodeClientMockRecorder) FindNode(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNode", reflect.TypeOf((*MockNodeClient)(nil).FindNode), varargs...)
+}
+
+// Ping mocks base method
+func (m *MockNodeClient) Ping(ctx context.Context, in *PeerInfo, opts ...grpc.CallOption) (*PeerInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Ping", varargs...)
+	ret0, _ := ret[0].(*PeerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping
+func (mr *MockNodeClientMockRecorder) Ping(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockNodeClient)(nil).Ping), varargs...)
+}
+
+// GetForkProof mocks base method
+func (m *MockNodeClient) GetForkProof(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*wire.ForkProof, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetForkProof", varargs...)
+	ret0, _ := ret[0].(*wire.ForkProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForkProof indicates an expected call of GetForkProof
+func (mr *MockNodeClientMockRecorder) GetForkProof(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForkProof", reflect.TypeOf((*MockNodeClient)(nil).GetForkProof), varargs...)
+}
+
+// MockNode_GetEventsClient is a mock of Node_GetEventsClient interface
+type MockNode_GetEventsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNode_GetEventsClientMockRecorder
+}
+
+// MockNode_GetEventsClientMockRecorder is the mock recorder for MockNode_GetEventsClient
+type MockNode_GetEventsClientMockRecorder struct {
+	mock *MockNode_GetEventsClient
+}
+
+// NewMockNode_GetEventsClient creates a new mock instance
+func NewMockNode_GetEventsClient(ctrl *gomock.Controller) *MockNode_GetEventsClient {
+	mock := &MockNode_GetEventsClient{ctrl: ctrl}
+	mock.recorder = &MockNode_GetEventsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNode_GetEventsClient) EXPECT() *MockNode_GetEventsClientMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method
+func (m *MockNode_GetEventsClient) Recv() (*wire.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*wire.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockNode_GetEventsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockNode_GetEventsClient)(nil).Recv))
+}
+
+// Header mocks base method
+func (m *MockNode_GetEventsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockNode_GetEventsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockNode_GetEventsClient)(nil).Header))
+}
+
+// Trailer mocks base method
+func (m *MockNode_GetEventsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockNode_GetEventsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockNode_GetEventsClient)(nil).Trailer))
+}
+
+// CloseSend mocks base method
+func (m *MockNode_GetEventsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockNode_GetEventsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockNode_GetEventsClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockNode_GetEventsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockNode_GetEventsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNode_GetEventsClient)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockNode_GetEventsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockNode_GetEventsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNode_GetEventsClient)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockNode_GetEventsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockNode_GetEventsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNode_GetEventsClient)(nil).RecvMsg), m)
+}
+
 // MockNodeServer is a mock of NodeServer interface
 type MockNodeServer struct {
 	ctrl     *gomock.Controller
@@ -148,6 +372,35 @@ func (mr *MockNodeServerMockRecorder) GetEvent(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockNodeServer)(nil).GetEvent), arg0, arg1)
 }
 
+// GetEvents mocks base method
+func (m *MockNodeServer) GetEvents(arg0 *EventsRequest, arg1 Node_GetEventsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetEvents indicates an expected call of GetEvents
+func (mr *MockNodeServerMockRecorder) GetEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockNodeServer)(nil).GetEvents), arg0, arg1)
+}
+
+// AnnounceEvents mocks base method
+func (m *MockNodeServer) AnnounceEvents(arg0 context.Context, arg1 *EventHashes) (*EventHashes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnounceEvents", arg0, arg1)
+	ret0, _ := ret[0].(*EventHashes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnounceEvents indicates an expected call of AnnounceEvents
+func (mr *MockNodeServerMockRecorder) AnnounceEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnounceEvents", reflect.TypeOf((*MockNodeServer)(nil).AnnounceEvents), arg0, arg1)
+}
+
 // GetPeerInfo mocks base method
 func (m *MockNodeServer) GetPeerInfo(arg0 context.Context, arg1 *PeerRequest) (*PeerInfo, error) {
 	m.ctrl.T.Helper()
@@ -162,3 +415,167 @@ func (mr *MockNodeServerMockRecorder) GetPeerInfo(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerInfo", reflect.TypeOf((*MockNodeServer)(nil).GetPeerInfo), arg0, arg1)
 }
+
+// FindNode mocks base method
+func (m *MockNodeServer) FindNode(arg0 context.Context, arg1 *NodeLookup) (*PeerInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNode", arg0, arg1)
+	ret0, _ := ret[0].(*PeerInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNode indicates an expected call of FindNode
+func (mr *MockNodeServerMockRecorder) FindNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNode", reflect.TypeOf((*MockNodeServer)(nil).FindNode), arg0, arg1)
+}
+
+// Ping mocks base method
+func (m *MockNodeServer) Ping(arg0 context.Context, arg1 *PeerInfo) (*PeerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0, arg1)
+	ret0, _ := ret[0].(*PeerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping
+func (mr *MockNodeServerMockRecorder) Ping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockNodeServer)(nil).Ping), arg0, arg1)
+}
+
+// GetForkProof mocks base method
+func (m *MockNodeServer) GetForkProof(arg0 context.Context, arg1 *PeerRequest) (*wire.ForkProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForkProof", arg0, arg1)
+	ret0, _ := ret[0].(*wire.ForkProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForkProof indicates an expected call of GetForkProof
+func (mr *MockNodeServerMockRecorder) GetForkProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForkProof", reflect.TypeOf((*MockNodeServer)(nil).GetForkProof), arg0, arg1)
+}
+
+// MockNode_GetEventsServer is a mock of Node_GetEventsServer interface
+type MockNode_GetEventsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNode_GetEventsServerMockRecorder
+}
+
+// MockNode_GetEventsServerMockRecorder is the mock recorder for MockNode_GetEventsServer
+type MockNode_GetEventsServerMockRecorder struct {
+	mock *MockNode_GetEventsServer
+}
+
+// NewMockNode_GetEventsServer creates a new mock instance
+func NewMockNode_GetEventsServer(ctrl *gomock.Controller) *MockNode_GetEventsServer {
+	mock := &MockNode_GetEventsServer{ctrl: ctrl}
+	mock.recorder = &MockNode_GetEventsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNode_GetEventsServer) EXPECT() *MockNode_GetEventsServerMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockNode_GetEventsServer) Send(arg0 *wire.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockNode_GetEventsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockNode_GetEventsServer)(nil).Send), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockNode_GetEventsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockNode_GetEventsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockNode_GetEventsServer)(nil).SetHeader), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockNode_GetEventsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockNode_GetEventsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockNode_GetEventsServer)(nil).SendHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockNode_GetEventsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockNode_GetEventsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockNode_GetEventsServer)(nil).SetTrailer), arg0)
+}
+
+// Context mocks base method
+func (m *MockNode_GetEventsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockNode_GetEventsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockNode_GetEventsServer)(nil).Context))
+}
+
+// SendMsg mocks base method
+func (m_2 *MockNode_GetEventsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockNode_GetEventsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockNode_GetEventsServer)(nil).SendMsg), m)
+}
+
+// RecvMsg mocks base method
+func (m_2 *MockNode_GetEventsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockNode_GetEventsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockNode_GetEventsServer)(nil).RecvMsg), m)
+}
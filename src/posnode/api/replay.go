@@ -0,0 +1,210 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// replaySkew is a max time request may come from the future (clock difference).
+	replaySkew = 5 * time.Second
+	// replayClientNonces is a max count of remembered nonces per client.
+	replayClientNonces = 100
+)
+
+var (
+	// ErrRequestOutOfWindow is a reason of request rejection by time window.
+	ErrRequestOutOfWindow = errors.New("request time is out of acceptance window")
+	// ErrRequestTooOld is a reason of request rejection if its nonce could be forgotten.
+	ErrRequestTooOld = errors.New("request is older than remembered nonces")
+	// ErrRequestReplayed is a reason of request rejection by already seen nonce.
+	ErrRequestReplayed = errors.New("request nonce is already seen")
+)
+
+type (
+	// ReplayGuard rejects signed requests out of time window or with already seen nonce.
+	// Nonces are remembered in bounded cache per client, so requests of client older than
+	// its forgotten nonce are rejected too. Client traffic evicts its own nonces only,
+	// others' are forgotten if there are too many clients.
+	ReplayGuard struct {
+		window  time.Duration
+		skew    time.Duration
+		size    int
+		nonces  int        // per client
+		clients *lru.Cache // client -> *seenStamps
+		floors  map[string]int64
+
+		sync.Mutex
+	}
+
+	// requestStamp makes signed request unique.
+	requestStamp struct {
+		Time  int64 // unix nano
+		Nonce string
+	}
+
+	// seenStamps is a remembered request stamps of client.
+	seenStamps struct {
+		nonces *lru.Cache // nonce -> time
+		last   int64
+	}
+)
+
+// NewReplayGuard creates guard with acceptance window (max request age)
+// and total nonce cache size.
+func NewReplayGuard(window time.Duration, size int) *ReplayGuard {
+	g := &ReplayGuard{
+		window: window,
+		skew:   replaySkew,
+		size:   size,
+		nonces: replayClientNonces,
+		floors: make(map[string]int64),
+	}
+	if g.skew > window {
+		g.skew = window
+	}
+	if g.nonces > size {
+		g.nonces = size
+	}
+
+	var err error
+	g.clients, err = lru.NewWithEvict(size/g.nonces, g.forgetClient)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+// Check returns error if request stamp of client is not acceptable, remembers it otherwise.
+func (g *ReplayGuard) Check(client string, stamp requestStamp) error {
+	now := time.Now().UnixNano()
+	if stamp.Time < now-int64(g.window) || stamp.Time > now+int64(g.skew) {
+		return ErrRequestOutOfWindow
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	if stamp.Time <= g.floors[client] {
+		return ErrRequestTooOld
+	}
+
+	seen := g.seenOf(client)
+	if seen.nonces.Contains(stamp.Nonce) {
+		return ErrRequestReplayed
+	}
+	seen.nonces.Add(stamp.Nonce, stamp.Time)
+	if stamp.Time > seen.last {
+		seen.last = stamp.Time
+	}
+
+	return nil
+}
+
+// seenOf returns remembered stamps of client.
+// It is called under lock.
+func (g *ReplayGuard) seenOf(client string) *seenStamps {
+	if val, ok := g.clients.Get(client); ok {
+		return val.(*seenStamps)
+	}
+
+	nonces, err := lru.NewWithEvict(g.nonces, func(_, val interface{}) {
+		g.raiseFloor(client, val.(int64))
+	})
+	if err != nil {
+		panic(err)
+	}
+	seen := &seenStamps{
+		nonces: nonces,
+	}
+	g.clients.Add(client, seen)
+	return seen
+}
+
+// forgetClient raises floor of client to its last stamp on eviction of all its nonces.
+// It is called under lock.
+func (g *ReplayGuard) forgetClient(key, val interface{}) {
+	g.raiseFloor(key.(string), val.(*seenStamps).last)
+}
+
+// raiseFloor raises floor of acceptable request time of client on nonce eviction.
+// It is called under lock.
+func (g *ReplayGuard) raiseFloor(client string, stamp int64) {
+	if stamp > g.floors[client] {
+		g.floors[client] = stamp
+	}
+
+	if len(g.floors) > g.size {
+		g.purgeFloors()
+	}
+}
+
+// purgeFloors erases floors out of window, the window rejects such requests anyway.
+// It is called under lock.
+func (g *ReplayGuard) purgeFloors() {
+	old := time.Now().UnixNano() - int64(g.window)
+	for client, floor := range g.floors {
+		if floor < old {
+			delete(g.floors, client)
+		}
+	}
+}
+
+/*
+ * Utils:
+ */
+
+func newRequestStamp() requestStamp {
+	var nonce [16]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		panic(err)
+	}
+
+	return requestStamp{
+		Time:  time.Now().UnixNano(),
+		Nonce: base64.StdEncoding.EncodeToString(nonce[:]),
+	}
+}
+
+// Pairs returns metadata key-value pairs.
+func (s requestStamp) Pairs() []string {
+	return []string{
+		"time", strconv.FormatInt(s.Time, 10),
+		"nonce", s.Nonce,
+	}
+}
+
+// Bytes returns representation for sign.
+func (s requestStamp) Bytes() []byte {
+	return []byte(strconv.FormatInt(s.Time, 10) + "/" + s.Nonce)
+}
+
+// readStamp reads request stamp from metadata.
+func readStamp(md metadata.MD) (s requestStamp, err error) {
+	times, ok := md["time"]
+	if !ok || len(times) < 1 {
+		err = errors.New("data should be stamped: no time")
+		return
+	}
+	s.Time, err = strconv.ParseInt(times[0], 10, 64)
+	if err != nil {
+		return
+	}
+
+	nonces, ok := md["nonce"]
+	if !ok || len(nonces) < 1 || nonces[0] == "" {
+		err = errors.New("data should be stamped: no nonce")
+		return
+	}
+	s.Nonce = nonces[0]
+
+	return
+}
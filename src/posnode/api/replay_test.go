@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+	"github.com/Fantom-foundation/go-lachesis/src/network"
+)
+
+func TestReplayGuard(t *testing.T) {
+
+	t.Run("window", func(t *testing.T) {
+		assert := assert.New(t)
+
+		guard := NewReplayGuard(time.Minute, 10)
+
+		stamp := newRequestStamp()
+		assert.NoError(guard.Check("client", stamp))
+		assert.Equal(ErrRequestReplayed, guard.Check("client", stamp))
+		assert.NoError(guard.Check("other", stamp), "nonces are per client")
+
+		stamp = newRequestStamp()
+		stamp.Time -= int64(2 * time.Minute)
+		assert.Equal(ErrRequestOutOfWindow, guard.Check("client", stamp))
+
+		stamp = newRequestStamp()
+		stamp.Time += int64(2 * time.Minute)
+		assert.Equal(ErrRequestOutOfWindow, guard.Check("client", stamp))
+
+		stamp = newRequestStamp()
+		stamp.Time += int64(2 * replaySkew)
+		assert.Equal(ErrRequestOutOfWindow, guard.Check("client", stamp), "future is limited by skew")
+
+		stamp = newRequestStamp()
+		stamp.Time += int64(replaySkew / 2)
+		assert.NoError(guard.Check("client", stamp), "small clock difference")
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		assert := assert.New(t)
+
+		guard := NewReplayGuard(time.Minute, 2)
+
+		first := newRequestStamp()
+		assert.NoError(guard.Check("client", first))
+		assert.NoError(guard.Check("client", newRequestStamp()))
+		assert.NoError(guard.Check("client", newRequestStamp()))
+
+		assert.Equal(ErrRequestTooOld, guard.Check("client", first), "forgotten nonce")
+	})
+
+	t.Run("eviction per client", func(t *testing.T) {
+		assert := assert.New(t)
+
+		guard := NewReplayGuard(time.Minute, 2)
+
+		honest := newRequestStamp()
+
+		// attacker floods the cache with its own stamps
+		for i := 0; i < 10; i++ {
+			stamp := newRequestStamp()
+			stamp.Time += int64(replaySkew / 2)
+			assert.NoError(guard.Check("attacker", stamp))
+		}
+
+		assert.NoError(guard.Check("honest", honest), "floor of other client is not raised")
+		assert.NoError(guard.Check("honest", newRequestStamp()))
+	})
+
+	t.Run("nonces per client", func(t *testing.T) {
+		assert := assert.New(t)
+
+		guard := NewReplayGuard(time.Minute, 2*replayClientNonces)
+
+		older := newRequestStamp()
+		seen := newRequestStamp()
+		assert.NoError(guard.Check("honest", seen))
+
+		// attacker evicts its own nonces only
+		for i := 0; i < 2*replayClientNonces; i++ {
+			assert.NoError(guard.Check("attacker", newRequestStamp()))
+		}
+
+		assert.Equal(ErrRequestReplayed, guard.Check("honest", seen), "nonce is remembered")
+		assert.NoError(guard.Check("honest", older), "floor is not raised")
+	})
+}
+
+func TestReplayedRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := "replay-client.fake"
+	dialer := grpc.WithContextDialer(network.FakeDialer(from))
+
+	serverKey := crypto.GenerateKey()
+	clientKey := crypto.GenerateKey()
+
+	svc := NewMockNodeServer(ctrl)
+	svc.EXPECT().
+		GetEvent(gomock.Any(), gomock.Any()).
+		Return(&wire.Event{}, nil).
+		Times(1)
+
+	server, addr := StartService("replay-server.fake:0", serverKey, gen, NewReplayGuard(time.Minute, 100), nil, svc, t.Logf, network.FakeListener)
+	defer server.Stop()
+
+	// client which remembers signed metadata
+	var captured metadata.MD
+	auth := ClientAuth(clientKey, gen)
+	capture := func(ctx context.Context, method string, req, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return auth(ctx, method, req, resp, cc, func(ctx context.Context, method string, req, resp interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			captured, _ = metadata.FromOutgoingContext(ctx)
+			return invoker(ctx, method, req, resp, cc, opts...)
+		}, opts...)
+	}
+	conn, err := grpc.DialContext(context.Background(), addr, dialer, grpc.WithInsecure(), grpc.WithUnaryInterceptor(capture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	req := &EventRequest{Index: 1}
+	_, err = NewNodeClient(conn).GetEvent(context.Background(), req)
+	if !assert.NoError(err) {
+		return
+	}
+
+	// replay of the same signed request
+	raw, err := grpc.DialContext(context.Background(), addr, dialer, grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer raw.Close()
+
+	ctx := metadata.NewOutgoingContext(context.Background(), captured)
+	_, err = NewNodeClient(raw).GetEvent(ctx, req)
+	assert.Equal(codes.Unauthenticated, status.Code(err))
+	assert.Contains(status.Convert(err).Message(), ErrRequestReplayed.Error())
+}
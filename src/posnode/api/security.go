@@ -29,8 +29,9 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 	return func(ctx context.Context, method string, req interface{}, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		// request:
 
-		servSign := signData(req, key)
-		md := metadata.Pairs("sign", servSign, "pub", pub, "genesis", gen)
+		stamp := newRequestStamp()
+		servSign := signData(req, key, []byte(gen), stamp.Bytes())
+		md := metadata.Pairs(append([]string{"sign", servSign, "pub", pub, "genesis", gen}, stamp.Pairs()...)...)
 		ctx = metadata.NewOutgoingContext(ctx, md)
 
 		var answer metadata.MD
@@ -51,7 +52,7 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 			return status.Errorf(codes.Unauthenticated, "peer's genesis does not match")
 		}
 
-		err = verifyData(resp, servSign, servPub)
+		err = verifyData(resp, servSign, servPub, []byte(gen), stamp.Bytes())
 		if err != nil {
 			return status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -66,14 +67,15 @@ func ClientAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryClientInter
 }
 
 // ServerAuth makes server-side interceptor for identification.
-func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInterceptor {
+// Replayed requests are rejected by guard.
+func ServerAuth(key *common.PrivateKey, genesis hash.Hash, guard *ReplayGuard) grpc.UnaryServerInterceptor {
 	pub := base64.StdEncoding.EncodeToString(key.Public().Bytes())
 	gen := genesis.Hex()
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// request:
 
-		clientSign, clientPub, clientGen, err := parseContext(ctx)
+		clientSign, clientPub, clientGen, stamp, err := parseContext(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -82,7 +84,12 @@ func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInter
 			return nil, status.Errorf(codes.Unauthenticated, "peer's genesis does not match")
 		}
 
-		err = verifyData(req, clientSign, clientPub)
+		err = verifyData(req, clientSign, clientPub, []byte(gen), stamp.Bytes())
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+
+		err = guard.Check(clientPub.Base64(), stamp)
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, err.Error())
 		}
@@ -93,8 +100,11 @@ func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInter
 		// response:
 
 		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
 
-		sign := signData(resp, key)
+		sign := signData(resp, key, []byte(gen), stamp.Bytes())
 		md := metadata.Pairs("sign", sign, "pub", pub, "genesis", gen)
 		if err := grpc.SetTrailer(ctx, md); err != nil {
 			logger.Get().Fatal(err)
@@ -104,8 +114,8 @@ func ServerAuth(key *common.PrivateKey, genesis hash.Hash) grpc.UnaryServerInter
 	}
 }
 
-// parseContext reads fields from request/response context.
-func parseContext(ctx context.Context) (sign string, pub *common.PublicKey, gen string, err error) {
+// parseContext reads fields from request context.
+func parseContext(ctx context.Context) (sign string, pub *common.PublicKey, gen string, stamp requestStamp, err error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		err = errors.New("data should be signed")
@@ -117,6 +127,7 @@ func parseContext(ctx context.Context) (sign string, pub *common.PublicKey, gen
 		return
 	}
 
+	stamp, err = readStamp(md)
 	return
 }
 
@@ -146,16 +157,18 @@ func readMetadata(md metadata.MD) (sign string, pub *common.PublicKey, gen strin
 	return
 }
 
-func signData(data interface{}, key *common.PrivateKey) string {
-	h := hashOfData(data)
+// signData signs data with extra fields.
+func signData(data interface{}, key *common.PrivateKey, extra ...[]byte) string {
+	h := hashOfData(data, extra...)
 
 	R, S, _ := key.Sign(h.Bytes())
 
 	return crypto.EncodeSignature(R, S)
 }
 
-func verifyData(data interface{}, sign string, pub *common.PublicKey) error {
-	h := hashOfData(data)
+// verifyData verifies sign of data with extra fields.
+func verifyData(data interface{}, sign string, pub *common.PublicKey, extra ...[]byte) error {
+	h := hashOfData(data, extra...)
 
 	r, s, err := crypto.DecodeSignature(sign)
 	if err != nil {
@@ -169,23 +182,26 @@ func verifyData(data interface{}, sign string, pub *common.PublicKey) error {
 	return nil
 }
 
-func hashOfData(data interface{}) hash.Hash {
+func hashOfData(data interface{}, extra ...[]byte) hash.Hash {
 	d, ok := data.(proto.Message)
 	if !ok {
 		panic("data is not proto.Message")
 	}
 
-	if IsProtoEmpty(&d) {
-		return hash.Hash{}
+	var h hash.Hash
+	if !IsProtoEmpty(&d) {
+		var pbf proto.Buffer
+		pbf.SetDeterministic(true)
+		if err := pbf.Marshal(d); err != nil {
+			logger.Get().Fatal(err)
+		}
+		h = hash.Of(pbf.Bytes())
 	}
 
-	var pbf proto.Buffer
-	pbf.SetDeterministic(true)
-	if err := pbf.Marshal(d); err != nil {
-		logger.Get().Fatal(err)
+	if len(extra) < 1 {
+		return h
 	}
-
-	return hash.Of(pbf.Bytes())
+	return hash.Of(append([][]byte{h.Bytes()}, extra...)...)
 }
 
 // IsProtoEmpty return true if it is typed nil (by protobuf sources).
@@ -195,3 +211,207 @@ func IsProtoEmpty(m *proto.Message) bool {
 	// return valToPointer(reflect.ValueOf(*m))
 	return m == nil || (*[2]unsafe.Pointer)(unsafe.Pointer(m))[1] == nil
 }
+
+// ClientStreamAuth makes client-side stream interceptor for identification.
+// Stream is opened with request sign, so it waits for the request message.
+func ClientStreamAuth(key *common.PrivateKey, genesis hash.Hash) grpc.StreamClientInterceptor {
+	pub := key.Public().Base64()
+	gen := genesis.Hex()
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if desc.ClientStreams {
+			return nil, status.Errorf(codes.Unimplemented, "client streams are not supported")
+		}
+
+		stamp := newRequestStamp()
+		s := &authClientStream{
+			ctx: ctx,
+			open: func(req interface{}) (grpc.ClientStream, error) {
+				sign := signData(req, key, []byte(gen), stamp.Bytes())
+				md := metadata.Pairs(append([]string{"sign", sign, "pub", pub, "genesis", gen}, stamp.Pairs()...)...)
+				ctx := metadata.NewOutgoingContext(ctx, md)
+				return streamer(ctx, desc, cc, method, opts...)
+			},
+			verify: func(req interface{}, answer metadata.MD) error {
+				servSign, servPub, servGen, err := readMetadata(answer)
+				if err != nil {
+					return status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				if servGen != gen {
+					return status.Errorf(codes.Unauthenticated, "peer's genesis does not match")
+				}
+
+				err = verifyData(req, servSign, servPub, []byte(gen), stamp.Bytes())
+				if err != nil {
+					return status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				if set, ok := ctx.Value(peerID{}).(func(hash.Peer)); ok {
+					serverID := hash.PeerOfPubkey(servPub)
+					set(serverID)
+				}
+				return nil
+			},
+		}
+		return s, nil
+	}
+}
+
+// ServerStreamAuth makes server-side stream interceptor for identification.
+// Replayed requests are rejected by guard.
+func ServerStreamAuth(key *common.PrivateKey, genesis hash.Hash, guard *ReplayGuard) grpc.StreamServerInterceptor {
+	pub := base64.StdEncoding.EncodeToString(key.Public().Bytes())
+	gen := genesis.Hex()
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.IsClientStream {
+			return status.Errorf(codes.Unimplemented, "client streams are not supported")
+		}
+
+		s := &authServerStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			verify: func(ctx context.Context, req interface{}) (context.Context, error) {
+				clientSign, clientPub, clientGen, stamp, err := parseContext(ctx)
+				if err != nil {
+					return ctx, status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				if clientGen != gen {
+					return ctx, status.Errorf(codes.Unauthenticated, "peer's genesis does not match")
+				}
+
+				err = verifyData(req, clientSign, clientPub, []byte(gen), stamp.Bytes())
+				if err != nil {
+					return ctx, status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				err = guard.Check(clientPub.Base64(), stamp)
+				if err != nil {
+					return ctx, status.Errorf(codes.Unauthenticated, err.Error())
+				}
+
+				// response is signed by request sign
+				sign := signData(req, key, []byte(gen), stamp.Bytes())
+				md := metadata.Pairs("sign", sign, "pub", pub, "genesis", gen)
+				if err := ss.SendHeader(md); err != nil {
+					return ctx, err
+				}
+
+				clientID := hash.PeerOfPubkey(clientPub)
+				return context.WithValue(ctx, peerID{}, clientID), nil
+			},
+		}
+
+		return handler(srv, s)
+	}
+}
+
+// authClientStream opens stream with the first (request) message
+// and verifies server by response header.
+type authClientStream struct {
+	grpc.ClientStream
+
+	ctx      context.Context
+	open     func(req interface{}) (grpc.ClientStream, error)
+	verify   func(req interface{}, answer metadata.MD) error
+	req      interface{}
+	verified bool
+}
+
+func (s *authClientStream) Context() context.Context {
+	if s.ClientStream == nil {
+		return s.ctx
+	}
+	return s.ClientStream.Context()
+}
+
+func (s *authClientStream) SendMsg(m interface{}) error {
+	if s.ClientStream != nil {
+		return status.Errorf(codes.Unimplemented, "client streams are not supported")
+	}
+
+	var err error
+	s.ClientStream, err = s.open(m)
+	if err != nil {
+		return err
+	}
+	s.req = m
+
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *authClientStream) CloseSend() error {
+	if s.ClientStream == nil {
+		return errors.New("stream is not opened")
+	}
+	return s.ClientStream.CloseSend()
+}
+
+func (s *authClientStream) Header() (metadata.MD, error) {
+	if s.ClientStream == nil {
+		return nil, errors.New("stream is not opened")
+	}
+	return s.ClientStream.Header()
+}
+
+func (s *authClientStream) Trailer() metadata.MD {
+	if s.ClientStream == nil {
+		return nil
+	}
+	return s.ClientStream.Trailer()
+}
+
+func (s *authClientStream) RecvMsg(m interface{}) error {
+	if s.ClientStream == nil {
+		return errors.New("stream is not opened")
+	}
+
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.verified {
+		answer, err := s.ClientStream.Header()
+		if err != nil {
+			return err
+		}
+		if err = s.verify(s.req, answer); err != nil {
+			return err
+		}
+		s.verified = true
+	}
+
+	return nil
+}
+
+// authServerStream verifies client by the first (request) message.
+type authServerStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	verify   func(ctx context.Context, req interface{}) (context.Context, error)
+	verified bool
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.verified {
+		ctx, err := s.verify(s.ctx, m)
+		if err != nil {
+			return err
+		}
+		s.ctx = ctx
+		s.verified = true
+	}
+
+	return nil
+}
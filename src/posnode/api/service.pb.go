@@ -23,8 +23,11 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// KnownEvents is a last events indexes of creators.
+// Cheaters are creators caught on forks, their proofs are available by GetForkProof.
 type KnownEvents struct {
 	Lasts                map[string]uint64 `protobuf:"bytes,1,rep,name=Lasts,proto3" json:"Lasts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Cheaters             []string          `protobuf:"bytes,2,rep,name=Cheaters,proto3" json:"Cheaters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -62,6 +65,13 @@ func (m *KnownEvents) GetLasts() map[string]uint64 {
 	return nil
 }
 
+func (m *KnownEvents) GetCheaters() []string {
+	if m != nil {
+		return m.Cheaters
+	}
+	return nil
+}
+
 type EventRequest struct {
 	PeerID               string   `protobuf:"bytes,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
 	Index                uint64   `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
@@ -117,6 +127,111 @@ func (m *EventRequest) GetHash() []byte {
 	return nil
 }
 
+// EventsRequest is a range [From, To] of creator's events.
+// MaxSize limits total size of streamed events in bytes (0 means server's limit).
+type EventsRequest struct {
+	PeerID               string   `protobuf:"bytes,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	From                 uint64   `protobuf:"varint,2,opt,name=From,proto3" json:"From,omitempty"`
+	To                   uint64   `protobuf:"varint,3,opt,name=To,proto3" json:"To,omitempty"`
+	MaxSize              uint64   `protobuf:"varint,4,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsRequest) Reset()         { *m = EventsRequest{} }
+func (m *EventsRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsRequest) ProtoMessage()    {}
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{2}
+}
+
+func (m *EventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsRequest.Unmarshal(m, b)
+}
+func (m *EventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsRequest.Marshal(b, m, deterministic)
+}
+func (m *EventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsRequest.Merge(m, src)
+}
+func (m *EventsRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsRequest.Size(m)
+}
+func (m *EventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsRequest proto.InternalMessageInfo
+
+func (m *EventsRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *EventsRequest) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *EventsRequest) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *EventsRequest) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+// EventHashes is a list of event hashes.
+type EventHashes struct {
+	Hashes               [][]byte `protobuf:"bytes,1,rep,name=Hashes,proto3" json:"Hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventHashes) Reset()         { *m = EventHashes{} }
+func (m *EventHashes) String() string { return proto.CompactTextString(m) }
+func (*EventHashes) ProtoMessage()    {}
+func (*EventHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{3}
+}
+
+func (m *EventHashes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventHashes.Unmarshal(m, b)
+}
+func (m *EventHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventHashes.Marshal(b, m, deterministic)
+}
+func (m *EventHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHashes.Merge(m, src)
+}
+func (m *EventHashes) XXX_Size() int {
+	return xxx_messageInfo_EventHashes.Size(m)
+}
+func (m *EventHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHashes proto.InternalMessageInfo
+
+func (m *EventHashes) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
 type PeerRequest struct {
 	PeerID               string   `protobuf:"bytes,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -128,7 +243,7 @@ func (m *PeerRequest) Reset()         { *m = PeerRequest{} }
 func (m *PeerRequest) String() string { return proto.CompactTextString(m) }
 func (*PeerRequest) ProtoMessage()    {}
 func (*PeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0b84a42fa06f626, []int{2}
+	return fileDescriptor_a0b84a42fa06f626, []int{4}
 }
 
 func (m *PeerRequest) XXX_Unmarshal(b []byte) error {
@@ -157,19 +272,20 @@ func (m *PeerRequest) GetPeerID() string {
 }
 
 type PeerInfo struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	PubKey               []byte   `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
-	Host                 string   `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ID                   string     `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	PubKey               []byte     `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	Host                 string     `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	Addrs                *PeerAddrs `protobuf:"bytes,4,opt,name=Addrs,proto3" json:"Addrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *PeerInfo) Reset()         { *m = PeerInfo{} }
 func (m *PeerInfo) String() string { return proto.CompactTextString(m) }
 func (*PeerInfo) ProtoMessage()    {}
 func (*PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a0b84a42fa06f626, []int{3}
+	return fileDescriptor_a0b84a42fa06f626, []int{5}
 }
 
 func (m *PeerInfo) XXX_Unmarshal(b []byte) error {
@@ -211,41 +327,281 @@ func (m *PeerInfo) GetHost() string {
 	return ""
 }
 
+func (m *PeerInfo) GetAddrs() *PeerAddrs {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+// PeerAddrs is a list of peer endpoints signed by the peer.
+// Record with the greater Time replaces the older one.
+type PeerAddrs struct {
+	List                 []*PeerAddr `protobuf:"bytes,1,rep,name=List,proto3" json:"List,omitempty"`
+	Time                 int64       `protobuf:"varint,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Sign                 string      `protobuf:"bytes,3,opt,name=Sign,proto3" json:"Sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PeerAddrs) Reset()         { *m = PeerAddrs{} }
+func (m *PeerAddrs) String() string { return proto.CompactTextString(m) }
+func (*PeerAddrs) ProtoMessage()    {}
+func (*PeerAddrs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{6}
+}
+
+func (m *PeerAddrs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAddrs.Unmarshal(m, b)
+}
+func (m *PeerAddrs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAddrs.Marshal(b, m, deterministic)
+}
+func (m *PeerAddrs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAddrs.Merge(m, src)
+}
+func (m *PeerAddrs) XXX_Size() int {
+	return xxx_messageInfo_PeerAddrs.Size(m)
+}
+func (m *PeerAddrs) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAddrs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAddrs proto.InternalMessageInfo
+
+func (m *PeerAddrs) GetList() []*PeerAddr {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *PeerAddrs) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *PeerAddrs) GetSign() string {
+	if m != nil {
+		return m.Sign
+	}
+	return ""
+}
+
+// PeerAddr is a peer endpoint.
+// Advertised is for external address (e.g. NAT port mapping).
+type PeerAddr struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port                 uint32   `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=Time,proto3" json:"Time,omitempty"`
+	Advertised           bool     `protobuf:"varint,4,opt,name=Advertised,proto3" json:"Advertised,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerAddr) Reset()         { *m = PeerAddr{} }
+func (m *PeerAddr) String() string { return proto.CompactTextString(m) }
+func (*PeerAddr) ProtoMessage()    {}
+func (*PeerAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{7}
+}
+
+func (m *PeerAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerAddr.Unmarshal(m, b)
+}
+func (m *PeerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerAddr.Marshal(b, m, deterministic)
+}
+func (m *PeerAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAddr.Merge(m, src)
+}
+func (m *PeerAddr) XXX_Size() int {
+	return xxx_messageInfo_PeerAddr.Size(m)
+}
+func (m *PeerAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAddr proto.InternalMessageInfo
+
+func (m *PeerAddr) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *PeerAddr) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PeerAddr) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *PeerAddr) GetAdvertised() bool {
+	if m != nil {
+		return m.Advertised
+	}
+	return false
+}
+
+// NodeLookup asks for Count known peers closest to Target by XOR metric.
+type NodeLookup struct {
+	Target               string   `protobuf:"bytes,1,opt,name=Target,proto3" json:"Target,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeLookup) Reset()         { *m = NodeLookup{} }
+func (m *NodeLookup) String() string { return proto.CompactTextString(m) }
+func (*NodeLookup) ProtoMessage()    {}
+func (*NodeLookup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{8}
+}
+
+func (m *NodeLookup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLookup.Unmarshal(m, b)
+}
+func (m *NodeLookup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLookup.Marshal(b, m, deterministic)
+}
+func (m *NodeLookup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLookup.Merge(m, src)
+}
+func (m *NodeLookup) XXX_Size() int {
+	return xxx_messageInfo_NodeLookup.Size(m)
+}
+func (m *NodeLookup) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLookup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLookup proto.InternalMessageInfo
+
+func (m *NodeLookup) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *NodeLookup) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type PeerInfos struct {
+	Peers                []*PeerInfo `protobuf:"bytes,1,rep,name=Peers,proto3" json:"Peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *PeerInfos) Reset()         { *m = PeerInfos{} }
+func (m *PeerInfos) String() string { return proto.CompactTextString(m) }
+func (*PeerInfos) ProtoMessage()    {}
+func (*PeerInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{9}
+}
+
+func (m *PeerInfos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerInfos.Unmarshal(m, b)
+}
+func (m *PeerInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerInfos.Marshal(b, m, deterministic)
+}
+func (m *PeerInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerInfos.Merge(m, src)
+}
+func (m *PeerInfos) XXX_Size() int {
+	return xxx_messageInfo_PeerInfos.Size(m)
+}
+func (m *PeerInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerInfos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerInfos proto.InternalMessageInfo
+
+func (m *PeerInfos) GetPeers() []*PeerInfo {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*KnownEvents)(nil), "api.KnownEvents")
 	proto.RegisterMapType((map[string]uint64)(nil), "api.KnownEvents.LastsEntry")
 	proto.RegisterType((*EventRequest)(nil), "api.EventRequest")
+	proto.RegisterType((*EventsRequest)(nil), "api.EventsRequest")
+	proto.RegisterType((*EventHashes)(nil), "api.EventHashes")
 	proto.RegisterType((*PeerRequest)(nil), "api.PeerRequest")
 	proto.RegisterType((*PeerInfo)(nil), "api.PeerInfo")
+	proto.RegisterType((*PeerAddrs)(nil), "api.PeerAddrs")
+	proto.RegisterType((*PeerAddr)(nil), "api.PeerAddr")
+	proto.RegisterType((*NodeLookup)(nil), "api.NodeLookup")
+	proto.RegisterType((*PeerInfos)(nil), "api.PeerInfos")
 }
 
 func init() { proto.RegisterFile("service.proto", fileDescriptor_a0b84a42fa06f626) }
 
 var fileDescriptor_a0b84a42fa06f626 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4d, 0x6f, 0xda, 0x40,
-	0x10, 0xc5, 0x36, 0x20, 0x18, 0x43, 0x45, 0x57, 0x55, 0x65, 0xb9, 0x17, 0xcb, 0x52, 0x25, 0x1f,
-	0x8a, 0xdd, 0xd2, 0x0b, 0xca, 0x35, 0x7c, 0x04, 0x11, 0x45, 0xc8, 0xf9, 0x05, 0x8b, 0x19, 0x60,
-	0x15, 0xd8, 0x25, 0xde, 0x35, 0xc4, 0xf9, 0x29, 0xf9, 0xb5, 0x91, 0xd7, 0x0e, 0x41, 0xc9, 0x21,
-	0xb7, 0xf7, 0xde, 0xcc, 0xbc, 0xd9, 0x7d, 0x03, 0x5d, 0x89, 0xe9, 0x91, 0x25, 0x18, 0x1e, 0x52,
-	0xa1, 0x04, 0xb1, 0xe8, 0x81, 0xb9, 0xd7, 0x1b, 0xa6, 0xb6, 0xd9, 0x32, 0x4c, 0xc4, 0x3e, 0x9a,
-	0x50, 0xae, 0xc4, 0xbe, 0xbf, 0x16, 0x19, 0x5f, 0x51, 0xc5, 0x04, 0x8f, 0x36, 0xa2, 0xbf, 0xa3,
-	0xc9, 0x16, 0x25, 0x93, 0x91, 0x4c, 0x93, 0x88, 0x71, 0x85, 0x69, 0x74, 0x62, 0x29, 0x46, 0x78,
-	0x44, 0xae, 0x4a, 0x27, 0xff, 0x19, 0xec, 0x39, 0x17, 0x27, 0x3e, 0x2e, 0x34, 0x49, 0xfe, 0x41,
-	0xe3, 0x96, 0x4a, 0x25, 0x1d, 0xc3, 0xb3, 0x02, 0x7b, 0xf0, 0x2b, 0xa4, 0x07, 0x16, 0x5e, 0x34,
-	0x84, 0xba, 0x3a, 0xe6, 0x2a, 0xcd, 0xe3, 0xb2, 0xd3, 0x1d, 0x02, 0xbc, 0x8b, 0xa4, 0x07, 0xd6,
-	0x03, 0xe6, 0x8e, 0xe1, 0x19, 0x41, 0x3b, 0x2e, 0x20, 0xf9, 0x01, 0x8d, 0x23, 0xdd, 0x65, 0xe8,
-	0x98, 0x9e, 0x11, 0xd4, 0xe3, 0x92, 0x5c, 0x99, 0x43, 0xc3, 0x5f, 0x40, 0x47, 0xbb, 0xc6, 0xf8,
-	0x98, 0xa1, 0x54, 0xe4, 0x27, 0x34, 0x17, 0x88, 0xe9, 0x6c, 0x54, 0x8d, 0x57, 0xac, 0x70, 0x98,
-	0xf1, 0x15, 0x3e, 0xbd, 0x39, 0x68, 0x42, 0x08, 0xd4, 0x6f, 0xa8, 0xdc, 0x3a, 0x96, 0x67, 0x04,
-	0x9d, 0x58, 0x63, 0xff, 0x37, 0xd8, 0xc5, 0xcc, 0x17, 0x86, 0xfe, 0x04, 0x5a, 0x1a, 0xf1, 0xb5,
-	0x20, 0xdf, 0xc0, 0x3c, 0xd7, 0xcd, 0xd9, 0x48, 0xcf, 0x64, 0xcb, 0x39, 0xe6, 0x7a, 0x5b, 0x27,
-	0xae, 0x98, 0x5e, 0x27, 0xa4, 0xd2, 0xeb, 0xda, 0xb1, 0xc6, 0x83, 0x17, 0x03, 0xea, 0x77, 0x62,
-	0x85, 0x64, 0x00, 0x70, 0x9f, 0xf3, 0xa4, 0x0a, 0xb1, 0xf7, 0x31, 0x35, 0xf7, 0x93, 0xe2, 0xd7,
-	0xc8, 0x1f, 0x68, 0x4d, 0x51, 0x69, 0x4a, 0xbe, 0xeb, 0xfa, 0x65, 0x18, 0xae, 0x1d, 0x16, 0xb7,
-	0x2a, 0x35, 0xbf, 0x46, 0xfe, 0x82, 0x3d, 0x45, 0x75, 0x7e, 0x75, 0x69, 0x78, 0xf1, 0x57, 0xb7,
-	0x7b, 0x56, 0x8a, 0x06, 0xbf, 0xb6, 0x6c, 0xea, 0x03, 0xff, 0x7f, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xec, 0x36, 0x0b, 0x98, 0x3b, 0x02, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5b, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8e, 0x63, 0x87, 0x93, 0x4c, 0x2e, 0x70, 0x56, 0x47, 0x47, 0x96, 0x2b, 0x55, 0xa9, 0x7b,
+	0x51, 0x1e, 0x4a, 0x42, 0x83, 0x54, 0x21, 0xde, 0x10, 0x10, 0x8a, 0xa0, 0x55, 0xb4, 0x44, 0x7d,
+	0x37, 0xf1, 0x26, 0x59, 0x41, 0x76, 0xd3, 0xdd, 0x75, 0x20, 0xfd, 0x15, 0x7d, 0xea, 0xef, 0xad,
+	0x76, 0x7c, 0x21, 0x69, 0x90, 0x78, 0x9b, 0x6f, 0x2e, 0xdf, 0x37, 0x33, 0x9e, 0x35, 0x34, 0x35,
+	0x53, 0x4b, 0x3e, 0x66, 0xdd, 0x85, 0x92, 0x46, 0x12, 0x37, 0x5a, 0xf0, 0xe0, 0x74, 0xca, 0xcd,
+	0x2c, 0xb9, 0xed, 0x8e, 0xe5, 0xbc, 0x37, 0x88, 0x84, 0x91, 0xf3, 0xfd, 0x89, 0x4c, 0x44, 0x1c,
+	0x19, 0x2e, 0x45, 0x6f, 0x2a, 0xf7, 0xef, 0xa3, 0xf1, 0x8c, 0x69, 0xae, 0x7b, 0x5a, 0x8d, 0x7b,
+	0x5c, 0x18, 0xa6, 0x7a, 0x0f, 0x5c, 0xb1, 0x1e, 0x5b, 0x32, 0x61, 0x52, 0xa6, 0xf0, 0xb7, 0x03,
+	0xf5, 0x2b, 0x21, 0x1f, 0xc4, 0xb9, 0x75, 0x6a, 0xf2, 0x09, 0x2a, 0xd7, 0x91, 0x36, 0xda, 0x77,
+	0xda, 0x6e, 0xa7, 0xde, 0x7f, 0xd5, 0x8d, 0x16, 0xbc, 0xbb, 0x96, 0xd0, 0xc5, 0xe8, 0xb9, 0x30,
+	0x6a, 0x45, 0xd3, 0x4c, 0x12, 0x40, 0xf5, 0x74, 0xc6, 0x22, 0xc3, 0x94, 0xf6, 0xcb, 0x6d, 0xb7,
+	0x53, 0xa3, 0x05, 0x0e, 0x8e, 0x00, 0x9e, 0x0a, 0xc8, 0x1e, 0xb8, 0x77, 0x6c, 0xe5, 0x3b, 0x6d,
+	0xa7, 0x53, 0xa3, 0xd6, 0x24, 0xff, 0x41, 0x65, 0x19, 0xdd, 0x27, 0xcc, 0x2f, 0xb7, 0x9d, 0x8e,
+	0x47, 0x53, 0x70, 0x5c, 0x3e, 0x72, 0xc2, 0x21, 0x34, 0x50, 0x91, 0xb2, 0x1f, 0x09, 0xd3, 0x86,
+	0xfc, 0x0f, 0x3b, 0x43, 0xc6, 0xd4, 0xe5, 0x59, 0x56, 0x9e, 0x21, 0xcb, 0x70, 0x29, 0x62, 0xf6,
+	0x98, 0x33, 0x20, 0x20, 0x04, 0xbc, 0x2f, 0x91, 0x9e, 0xf9, 0x6e, 0xdb, 0xe9, 0x34, 0x28, 0xda,
+	0x21, 0x83, 0x66, 0x3a, 0xc3, 0x4b, 0x94, 0x04, 0xbc, 0x81, 0x92, 0xf3, 0x8c, 0x11, 0x6d, 0xd2,
+	0x82, 0xf2, 0x48, 0x22, 0x9d, 0x47, 0xcb, 0x23, 0x49, 0x7c, 0xf8, 0xe7, 0x6b, 0xf4, 0x78, 0xc3,
+	0x7f, 0x32, 0xdf, 0x43, 0x67, 0x0e, 0xc3, 0xf7, 0x50, 0x47, 0x19, 0xab, 0xc9, 0xb4, 0x15, 0x49,
+	0x2d, 0xdc, 0x68, 0x83, 0x66, 0xc8, 0xa6, 0x59, 0xb9, 0x17, 0x7a, 0x09, 0xef, 0xa1, 0x8a, 0x96,
+	0x98, 0x48, 0xdb, 0x43, 0x11, 0x2f, 0x5f, 0x9e, 0x61, 0x4d, 0x72, 0x7b, 0xc5, 0x56, 0xd8, 0x69,
+	0x83, 0x66, 0x08, 0x87, 0x97, 0xda, 0x60, 0xb7, 0x35, 0x8a, 0x36, 0x79, 0x07, 0x95, 0x93, 0x38,
+	0x56, 0x1a, 0xbb, 0xad, 0xf7, 0x5b, 0xf8, 0x5d, 0x2d, 0x33, 0x7a, 0x69, 0x1a, 0x0c, 0xbf, 0x43,
+	0xad, 0xf0, 0x91, 0x37, 0xe0, 0x5d, 0x73, 0x6d, 0xb2, 0x4b, 0x68, 0x6e, 0x54, 0x50, 0x0c, 0x59,
+	0xa5, 0x11, 0x9f, 0xa7, 0x5f, 0xcf, 0xa5, 0x68, 0x5b, 0xdf, 0x0d, 0x9f, 0x8a, 0x5c, 0xdd, 0xda,
+	0xe1, 0x04, 0xaa, 0x79, 0x65, 0xd1, 0x9d, 0xb3, 0xd6, 0x1d, 0x01, 0x6f, 0x28, 0x95, 0x41, 0x9e,
+	0x26, 0x45, 0xbb, 0xe0, 0x76, 0xd7, 0xb8, 0x5f, 0x03, 0x9c, 0xc4, 0x4b, 0xa6, 0x0c, 0xd7, 0x2c,
+	0xc6, 0x51, 0xaa, 0x74, 0xcd, 0x13, 0x1e, 0x03, 0x7c, 0x93, 0x31, 0xbb, 0x96, 0xf2, 0x2e, 0x59,
+	0xd8, 0xfd, 0x8c, 0x22, 0x35, 0x65, 0xb9, 0x56, 0x86, 0xec, 0xc9, 0x9c, 0xca, 0x44, 0xe4, 0x72,
+	0x29, 0x08, 0x0f, 0xa0, 0x96, 0x6f, 0x5a, 0x93, 0xb7, 0x50, 0xb1, 0x40, 0x6f, 0x0d, 0x6f, 0xc3,
+	0x34, 0x8d, 0xf5, 0x7f, 0xb9, 0xe0, 0x59, 0x39, 0xd2, 0x07, 0xb8, 0x59, 0x89, 0x71, 0xf6, 0x84,
+	0xf6, 0xfe, 0x7e, 0x33, 0xc1, 0x96, 0x27, 0x2c, 0x91, 0x8f, 0x50, 0xbd, 0x60, 0x06, 0x21, 0xf9,
+	0x17, 0xe3, 0xeb, 0xe7, 0x1e, 0xd4, 0xbb, 0xf6, 0xa9, 0xa6, 0xbe, 0xb0, 0x44, 0x0e, 0xa0, 0x96,
+	0x67, 0x6b, 0x42, 0x9e, 0xd2, 0xf5, 0xf3, 0xf9, 0x07, 0x0e, 0xf9, 0x0c, 0xad, 0x13, 0x21, 0x64,
+	0x22, 0xc6, 0x6c, 0xa3, 0xaf, 0xb5, 0xdb, 0x0c, 0xb6, 0x3c, 0xa8, 0x54, 0xbf, 0x60, 0xa6, 0xb8,
+	0xb9, 0xbd, 0x62, 0xf2, 0x5c, 0x69, 0x73, 0x17, 0x61, 0x89, 0xec, 0x43, 0x75, 0xc0, 0x45, 0x8c,
+	0x9b, 0xd8, 0xc5, 0xe0, 0xd3, 0x37, 0x08, 0x5a, 0x1b, 0xd9, 0x56, 0xe0, 0x03, 0x78, 0x43, 0x2e,
+	0xa6, 0x64, 0x93, 0x67, 0x9b, 0xf6, 0x10, 0x1a, 0x17, 0xcc, 0x0c, 0xa4, 0xba, 0x1b, 0x2a, 0x29,
+	0x27, 0xcf, 0x74, 0xb2, 0x9b, 0xce, 0x5c, 0xa4, 0x84, 0xa5, 0xdb, 0x1d, 0xfc, 0xab, 0x1d, 0xfe,
+	0x19, 0x00, 0xe6, 0x1b, 0xe9, 0x70, 0x30, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,7 +618,12 @@ const _ = grpc.SupportPackageIsVersion4
 type NodeClient interface {
 	SyncEvents(ctx context.Context, in *KnownEvents, opts ...grpc.CallOption) (*KnownEvents, error)
 	GetEvent(ctx context.Context, in *EventRequest, opts ...grpc.CallOption) (*wire.Event, error)
+	GetEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_GetEventsClient, error)
+	AnnounceEvents(ctx context.Context, in *EventHashes, opts ...grpc.CallOption) (*EventHashes, error)
 	GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerInfo, error)
+	FindNode(ctx context.Context, in *NodeLookup, opts ...grpc.CallOption) (*PeerInfos, error)
+	Ping(ctx context.Context, in *PeerInfo, opts ...grpc.CallOption) (*PeerInfo, error)
+	GetForkProof(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*wire.ForkProof, error)
 }
 
 type nodeClient struct {
@@ -291,6 +652,47 @@ func (c *nodeClient) GetEvent(ctx context.Context, in *EventRequest, opts ...grp
 	return out, nil
 }
 
+func (c *nodeClient) GetEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Node_GetEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Node_serviceDesc.Streams[0], "/api.Node/GetEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeGetEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_GetEventsClient interface {
+	Recv() (*wire.Event, error)
+	grpc.ClientStream
+}
+
+type nodeGetEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeGetEventsClient) Recv() (*wire.Event, error) {
+	m := new(wire.Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nodeClient) AnnounceEvents(ctx context.Context, in *EventHashes, opts ...grpc.CallOption) (*EventHashes, error) {
+	out := new(EventHashes)
+	err := c.cc.Invoke(ctx, "/api.Node/AnnounceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeClient) GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*PeerInfo, error) {
 	out := new(PeerInfo)
 	err := c.cc.Invoke(ctx, "/api.Node/GetPeerInfo", in, out, opts...)
@@ -300,11 +702,43 @@ func (c *nodeClient) GetPeerInfo(ctx context.Context, in *PeerRequest, opts ...g
 	return out, nil
 }
 
+func (c *nodeClient) FindNode(ctx context.Context, in *NodeLookup, opts ...grpc.CallOption) (*PeerInfos, error) {
+	out := new(PeerInfos)
+	err := c.cc.Invoke(ctx, "/api.Node/FindNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Ping(ctx context.Context, in *PeerInfo, opts ...grpc.CallOption) (*PeerInfo, error) {
+	out := new(PeerInfo)
+	err := c.cc.Invoke(ctx, "/api.Node/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) GetForkProof(ctx context.Context, in *PeerRequest, opts ...grpc.CallOption) (*wire.ForkProof, error) {
+	out := new(wire.ForkProof)
+	err := c.cc.Invoke(ctx, "/api.Node/GetForkProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	SyncEvents(context.Context, *KnownEvents) (*KnownEvents, error)
 	GetEvent(context.Context, *EventRequest) (*wire.Event, error)
+	GetEvents(*EventsRequest, Node_GetEventsServer) error
+	AnnounceEvents(context.Context, *EventHashes) (*EventHashes, error)
 	GetPeerInfo(context.Context, *PeerRequest) (*PeerInfo, error)
+	FindNode(context.Context, *NodeLookup) (*PeerInfos, error)
+	Ping(context.Context, *PeerInfo) (*PeerInfo, error)
+	GetForkProof(context.Context, *PeerRequest) (*wire.ForkProof, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -347,6 +781,45 @@ func _Node_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_GetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).GetEvents(m, &nodeGetEventsServer{stream})
+}
+
+type Node_GetEventsServer interface {
+	Send(*wire.Event) error
+	grpc.ServerStream
+}
+
+type nodeGetEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeGetEventsServer) Send(m *wire.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Node_AnnounceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).AnnounceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/AnnounceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).AnnounceEvents(ctx, req.(*EventHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Node_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PeerRequest)
 	if err := dec(in); err != nil {
@@ -365,6 +838,60 @@ func _Node_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_FindNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeLookup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).FindNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/FindNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).FindNode(ctx, req.(*NodeLookup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Ping(ctx, req.(*PeerInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_GetForkProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).GetForkProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/GetForkProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).GetForkProof(ctx, req.(*PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -377,11 +904,33 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvent",
 			Handler:    _Node_GetEvent_Handler,
 		},
+		{
+			MethodName: "AnnounceEvents",
+			Handler:    _Node_AnnounceEvents_Handler,
+		},
 		{
 			MethodName: "GetPeerInfo",
 			Handler:    _Node_GetPeerInfo_Handler,
 		},
+		{
+			MethodName: "FindNode",
+			Handler:    _Node_FindNode_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Node_Ping_Handler,
+		},
+		{
+			MethodName: "GetForkProof",
+			Handler:    _Node_GetForkProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetEvents",
+			Handler:       _Node_GetEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
@@ -59,17 +59,105 @@ func (m *PeerIDs) GetIDs() []string {
 	return nil
 }
 
+type Reputation struct {
+	UsefulEvents         uint64   `protobuf:"varint,1,opt,name=UsefulEvents,proto3" json:"UsefulEvents,omitempty"`
+	InvalidData          uint64   `protobuf:"varint,2,opt,name=InvalidData,proto3" json:"InvalidData,omitempty"`
+	Timeouts             uint64   `protobuf:"varint,3,opt,name=Timeouts,proto3" json:"Timeouts,omitempty"`
+	Latency              int64    `protobuf:"varint,4,opt,name=Latency,proto3" json:"Latency,omitempty"`
+	BannedUntil          int64    `protobuf:"varint,5,opt,name=BannedUntil,proto3" json:"BannedUntil,omitempty"`
+	BannedForever        bool     `protobuf:"varint,6,opt,name=BannedForever,proto3" json:"BannedForever,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reputation) Reset()         { *m = Reputation{} }
+func (m *Reputation) String() string { return proto.CompactTextString(m) }
+func (*Reputation) ProtoMessage()    {}
+func (*Reputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5219adf996163c1, []int{1}
+}
+
+func (m *Reputation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reputation.Unmarshal(m, b)
+}
+func (m *Reputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reputation.Marshal(b, m, deterministic)
+}
+func (m *Reputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reputation.Merge(m, src)
+}
+func (m *Reputation) XXX_Size() int {
+	return xxx_messageInfo_Reputation.Size(m)
+}
+func (m *Reputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reputation proto.InternalMessageInfo
+
+func (m *Reputation) GetUsefulEvents() uint64 {
+	if m != nil {
+		return m.UsefulEvents
+	}
+	return 0
+}
+
+func (m *Reputation) GetInvalidData() uint64 {
+	if m != nil {
+		return m.InvalidData
+	}
+	return 0
+}
+
+func (m *Reputation) GetTimeouts() uint64 {
+	if m != nil {
+		return m.Timeouts
+	}
+	return 0
+}
+
+func (m *Reputation) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *Reputation) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+func (m *Reputation) GetBannedForever() bool {
+	if m != nil {
+		return m.BannedForever
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PeerIDs)(nil), "api.PeerIDs")
+	proto.RegisterType((*Reputation)(nil), "api.Reputation")
 }
 
 func init() { proto.RegisterFile("stored.proto", fileDescriptor_c5219adf996163c1) }
 
 var fileDescriptor_c5219adf996163c1 = []byte{
-	// 75 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x2e, 0xc9, 0x2f,
-	0x4a, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x54, 0x92, 0xe6,
-	0x62, 0x0f, 0x48, 0x4d, 0x2d, 0xf2, 0x74, 0x29, 0x16, 0x12, 0xe0, 0x62, 0xf6, 0x74, 0x29, 0x96,
-	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x02, 0x31, 0x93, 0xd8, 0xc0, 0x0a, 0x8d, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x95, 0xd2, 0x54, 0x9f, 0x38, 0x00, 0x00, 0x00,
+	// 203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x41, 0x4a, 0xc6, 0x30,
+	0x10, 0x85, 0x89, 0xa9, 0x6d, 0x1d, 0x2b, 0x48, 0x56, 0x41, 0x37, 0xa1, 0xb8, 0xc8, 0xca, 0x8d,
+	0x37, 0x90, 0x2a, 0x14, 0x5c, 0x48, 0xb0, 0x07, 0x88, 0x76, 0x84, 0x40, 0x4d, 0x4a, 0x32, 0x2d,
+	0x78, 0x4b, 0x8f, 0x24, 0x8d, 0xf8, 0xd3, 0xee, 0xe6, 0xfb, 0xde, 0x63, 0xe0, 0x41, 0x93, 0x28,
+	0x44, 0x1c, 0xef, 0xe7, 0x18, 0x28, 0x08, 0x6e, 0x67, 0xd7, 0xde, 0x42, 0xf5, 0x8a, 0x18, 0xfb,
+	0x2e, 0x89, 0x6b, 0xe0, 0x7d, 0x97, 0x24, 0x53, 0x5c, 0x5f, 0x98, 0xed, 0x6c, 0x7f, 0x18, 0x80,
+	0xc1, 0x79, 0x21, 0x4b, 0x2e, 0x78, 0xd1, 0x42, 0x33, 0x24, 0xfc, 0x5c, 0xa6, 0xa7, 0x15, 0x3d,
+	0x6d, 0x4d, 0xa6, 0x0b, 0x73, 0x70, 0x42, 0xc1, 0x65, 0xef, 0x57, 0x3b, 0xb9, 0xb1, 0xb3, 0x64,
+	0xe5, 0x59, 0xae, 0xec, 0x95, 0xb8, 0x81, 0xfa, 0xcd, 0x7d, 0x61, 0x58, 0x28, 0x49, 0x9e, 0xe3,
+	0x13, 0x0b, 0x09, 0xd5, 0x8b, 0x25, 0xf4, 0x1f, 0xdf, 0xb2, 0x50, 0x4c, 0x73, 0xf3, 0x8f, 0xdb,
+	0xdf, 0x47, 0xeb, 0x3d, 0x8e, 0x83, 0x27, 0x37, 0xc9, 0xf3, 0x9c, 0xee, 0x95, 0xb8, 0x83, 0xab,
+	0x3f, 0x7c, 0x0e, 0x11, 0x57, 0x8c, 0xb2, 0x54, 0x4c, 0xd7, 0xe6, 0x28, 0xdf, 0xcb, 0xbc, 0xfd,
+	0xe1, 0x77, 0x00, 0x61, 0xbe, 0xab, 0x7d, 0x0b, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,314 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// certBindingOID is a private certificate extension id of node key binding.
+var certBindingOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 59999, 1, 1}
+
+const (
+	certBindingPrefix = "lachesis-tls-binding:"
+	certLifetime      = 365 * 24 * time.Hour
+)
+
+// certBinding is a certificate extension which binds TLS key to node key.
+type certBinding struct {
+	PubKey  []byte
+	Genesis []byte
+	Sign    []byte
+}
+
+// NewTLSConfig makes mutual TLS config with ephemeral certificate bound to node key.
+// Peer certificate is accepted if it is bound to any node key with the same genesis.
+func NewTLSConfig(key *common.PrivateKey, genesis hash.Hash) (*tls.Config, error) {
+	cert, err := newBoundCert(key, genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates:          []tls.Certificate{cert},
+		ClientAuth:            tls.RequireAnyClientCert,
+		InsecureSkipVerify:    true, // there is no CA, VerifyPeerCertificate checks binding
+		VerifyPeerCertificate: verifyBoundCert(genesis, hash.EmptyPeer),
+		MinVersion:            tls.VersionTLS12,
+	}, nil
+}
+
+// TLSServerOptions returns options of gRPC server identified by mutual TLS
+// and throttled by limiter (nil is unlimited).
+func TLSServerOptions(key *common.PrivateKey, genesis hash.Hash, limiter *Limiter) ([]grpc.ServerOption, error) {
+	config, err := NewTLSConfig(key, genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	return []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(config)),
+		grpc.UnaryInterceptor(chainUnaryServer(ServerTLSAuth(), limiter.ServerLimit())),
+		grpc.StreamInterceptor(chainStreamServer(ServerStreamTLSAuth(), limiter.ServerStreamLimit())),
+		grpc.MaxSendMsgSize(limiter.maxResponseSize()),
+	}, nil
+}
+
+// TLSDialOptions returns options of gRPC client identified by mutual TLS config (see NewTLSConfig()).
+// Server certificate should be bound to the key of server peer (empty is any).
+func TLSDialOptions(config *tls.Config, genesis hash.Hash, server hash.Peer) []grpc.DialOption {
+	config = config.Clone()
+	config.VerifyPeerCertificate = verifyBoundCert(genesis, server)
+
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(config)),
+		grpc.WithUnaryInterceptor(ClientTLSAuth()),
+		grpc.WithStreamInterceptor(ClientStreamTLSAuth()),
+	}
+}
+
+// ClientTLSAuth makes client-side interceptor to get server id from TLS connection.
+func ClientTLSAuth() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req interface{}, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		set, ok := ctx.Value(peerID{}).(func(hash.Peer))
+		if !ok {
+			return invoker(ctx, method, req, resp, cc, opts...)
+		}
+
+		var p peer.Peer
+		opts = append(opts, grpc.Peer(&p))
+		if err := invoker(ctx, method, req, resp, cc, opts...); err != nil {
+			return err
+		}
+
+		id, err := tlsPeerID(&p)
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		set(id)
+
+		return nil
+	}
+}
+
+// ClientStreamTLSAuth makes client-side stream interceptor to get server id from TLS connection.
+func ClientStreamTLSAuth() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if set, ok := ctx.Value(peerID{}).(func(hash.Peer)); ok {
+			p, _ := peer.FromContext(stream.Context())
+			id, err := tlsPeerID(p)
+			if err != nil {
+				return nil, status.Errorf(codes.Unauthenticated, err.Error())
+			}
+			set(id)
+		}
+
+		return stream, nil
+	}
+}
+
+// ServerTLSAuth makes server-side interceptor to get client id from TLS connection.
+func ServerTLSAuth() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		p, _ := peer.FromContext(ctx)
+		id, err := tlsPeerID(p)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+
+		ctx = context.WithValue(ctx, peerID{}, id)
+		return handler(ctx, req)
+	}
+}
+
+// ServerStreamTLSAuth makes server-side stream interceptor to get client id from TLS connection.
+func ServerStreamTLSAuth() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		p, _ := peer.FromContext(stream.Context())
+		id, err := tlsPeerID(p)
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &tlsServerStream{
+			ServerStream: stream,
+			ctx:          context.WithValue(stream.Context(), peerID{}, id),
+		})
+	}
+}
+
+/*
+ * Utils:
+ */
+
+// tlsServerStream overrides stream context.
+type tlsServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context with client id.
+func (s *tlsServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// tlsPeerID returns id of verified peer certificate.
+func tlsPeerID(p *peer.Peer) (hash.Peer, error) {
+	if p == nil {
+		return hash.EmptyPeer, errors.New("no peer info")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.PeerCertificates) < 1 {
+		return hash.EmptyPeer, errors.New("no peer certificate")
+	}
+
+	b, err := readBinding(info.State.PeerCertificates[0])
+	if err != nil {
+		return hash.EmptyPeer, err
+	}
+
+	return hash.PeerOfPubkeyBytes(b.PubKey), nil
+}
+
+// newBoundCert makes self-signed certificate of ephemeral key signed by node key.
+func newBoundCert(key *common.PrivateKey, genesis hash.Hash) (tls.Certificate, error) {
+	tlsKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	spki, err := x509.MarshalPKIXPublicKey(&tlsKey.PublicKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	R, S, err := key.Sign(bindingHash(spki, genesis).Bytes())
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	ext, err := asn1.Marshal(certBinding{
+		PubKey:  key.Public().Bytes(),
+		Genesis: genesis.Bytes(),
+		Sign:    []byte(crypto.EncodeSignature(R, S)),
+	})
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	id := hash.PeerOfPubkey(key.Public())
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: id.Hex()},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(certLifetime),
+		ExtraExtensions: []pkix.Extension{{
+			Id:    certBindingOID,
+			Value: ext,
+		}},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &tlsKey.PublicKey, tlsKey)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  tlsKey,
+	}, nil
+}
+
+// verifyBoundCert makes certificate verifier which checks binding to node key
+// of expected peer (empty is any).
+func verifyBoundCert(genesis hash.Hash, expected hash.Peer) func([][]byte, [][]*x509.Certificate) error {
+	return func(raw [][]byte, _ [][]*x509.Certificate) error {
+		if len(raw) != 1 {
+			return errors.New("single peer certificate expected")
+		}
+		cert, err := x509.ParseCertificate(raw[0])
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return errors.New("peer certificate is expired")
+		}
+		if err := cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature); err != nil {
+			return err
+		}
+
+		b, err := readBinding(cert)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(b.Genesis, genesis.Bytes()) {
+			return errors.New("peer's genesis does not match")
+		}
+
+		pub := common.BytesToPubkey(b.PubKey)
+		if pub.X == nil {
+			return errors.New("invalid peer key")
+		}
+		if !expected.IsEmpty() && hash.PeerOfPubkey(pub) != expected {
+			return errors.New("peer certificate is not of expected peer")
+		}
+		r, s, err := crypto.DecodeSignature(string(b.Sign))
+		if err != nil {
+			return err
+		}
+		if !pub.Verify(bindingHash(cert.RawSubjectPublicKeyInfo, genesis).Bytes(), r, s) {
+			return errors.New("peer certificate is not bound to peer key")
+		}
+
+		return nil
+	}
+}
+
+// readBinding returns node key binding of certificate.
+func readBinding(cert *x509.Certificate) (*certBinding, error) {
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(certBindingOID) {
+			continue
+		}
+		b := &certBinding{}
+		if _, err := asn1.Unmarshal(ext.Value, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+
+	return nil, errors.New("no node key binding in certificate")
+}
+
+func bindingHash(spki []byte, genesis hash.Hash) hash.Hash {
+	return hash.Of([]byte(certBindingPrefix), spki, genesis.Bytes())
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+	"github.com/Fantom-foundation/go-lachesis/src/network"
+)
+
+func TestTLS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := "tls-client.fake"
+	dialer := grpc.WithContextDialer(network.FakeDialer(from))
+
+	// keys
+	serverKey := crypto.GenerateKey()
+	serverID := hash.PeerOfPubkey(serverKey.Public())
+	clientKey := crypto.GenerateKey()
+	clientID := hash.PeerOfPubkey(clientKey.Public())
+
+	// service
+	svc := NewMockNodeServer(ctrl)
+	svc.EXPECT().
+		GetEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *EventRequest) (*wire.Event, error) {
+			assert.Equal(t, from, GrpcPeerHost(ctx))
+			assert.Equal(t, clientID, GrpcPeerID(ctx))
+			return &wire.Event{}, nil
+		}).
+		AnyTimes()
+	svc.EXPECT().
+		GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(req *EventsRequest, stream Node_GetEventsServer) error {
+			assert.Equal(t, clientID, GrpcPeerID(stream.Context()))
+			return stream.Send(&wire.Event{Index: req.From})
+		}).
+		AnyTimes()
+
+	// server
+	server, addr := StartTLSService("tls-server.fake:0", serverKey, gen, nil, svc, t.Logf, network.FakeListener)
+	defer server.Stop()
+
+	connect := func(key *common.PrivateKey, genesis hash.Hash, server hash.Peer) NodeClient {
+		config, err := NewTLSConfig(key, genesis)
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := TLSDialOptions(config, genesis, server)
+		conn, err := grpc.DialContext(context.Background(), addr, append(opts, dialer)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return NewNodeClient(conn)
+	}
+
+	t.Run("authorized", func(t *testing.T) {
+		assert := assert.New(t)
+
+		client := connect(clientKey, gen, serverID)
+
+		id, ctx := ServerPeerID(nil)
+		_, err := client.GetEvent(ctx, &EventRequest{})
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(serverID, *id)
+
+		id, ctx = ServerPeerID(nil)
+		stream, err := client.GetEvents(ctx, &EventsRequest{From: 5, To: 5})
+		if !assert.NoError(err) {
+			return
+		}
+		e, err := stream.Recv()
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(uint64(5), e.Index)
+		assert.Equal(serverID, *id)
+	})
+
+	t.Run("other genesis", func(t *testing.T) {
+		assert := assert.New(t)
+
+		client := connect(clientKey, hash.FakeHash(), serverID)
+
+		_, err := client.GetEvent(context.Background(), &EventRequest{})
+		assert.Error(err)
+	})
+
+	t.Run("other server", func(t *testing.T) {
+		assert := assert.New(t)
+
+		expected := hash.PeerOfPubkey(crypto.GenerateKey().Public())
+		client := connect(clientKey, gen, expected)
+
+		_, err := client.GetEvent(context.Background(), &EventRequest{})
+		assert.Error(err)
+	})
+
+	t.Run("plaintext client", func(t *testing.T) {
+		assert := assert.New(t)
+
+		conn, err := grpc.DialContext(context.Background(), addr,
+			dialer,
+			grpc.WithInsecure(),
+			grpc.WithUnaryInterceptor(ClientAuth(clientKey, gen)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		client := NewNodeClient(conn)
+
+		_, err = client.GetEvent(context.Background(), &EventRequest{})
+		assert.Error(err)
+	})
+
+	t.Run("certificate binding", func(t *testing.T) {
+		assert := assert.New(t)
+
+		cert, err := newBoundCert(clientKey, gen)
+		if !assert.NoError(err) {
+			return
+		}
+		verify := verifyBoundCert(gen, hash.EmptyPeer)
+		assert.NoError(verify(cert.Certificate, nil))
+		assert.NoError(verifyBoundCert(gen, clientID)(cert.Certificate, nil))
+
+		assert.Error(verifyBoundCert(hash.FakeHash(), hash.EmptyPeer)(cert.Certificate, nil), "genesis")
+		assert.Error(verifyBoundCert(gen, serverID)(cert.Certificate, nil), "peer")
+		assert.Error(verify([][]byte{[]byte("garbage")}, nil))
+	})
+}
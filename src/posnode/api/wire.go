@@ -20,16 +20,39 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/common"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/logger"
 	"github.com/Fantom-foundation/go-lachesis/src/network"
 )
 
 // StartService starts and returns gRPC server.
-func StartService(bind string, key *common.PrivateKey, genesis hash.Hash, svc NodeServer, log func(string, ...interface{}), listen network.ListenFunc) (
+// Requests and responses are identified by signs, replayed requests are rejected by guard.
+// Identified requests are throttled by limiter (nil is unlimited).
+func StartService(bind string, key *common.PrivateKey, genesis hash.Hash, guard *ReplayGuard, limiter *Limiter, svc NodeServer, log func(string, ...interface{}), listen network.ListenFunc) (
 	*grpc.Server, string) {
-	server := grpc.NewServer(
-		grpc.UnaryInterceptor(ServerAuth(key, genesis)),
-		grpc.MaxRecvMsgSize(math.MaxInt32),
-		grpc.MaxSendMsgSize(math.MaxInt32))
+	return startService(bind, svc, log, listen,
+		grpc.UnaryInterceptor(chainUnaryServer(ServerAuth(key, genesis, guard), limiter.ServerLimit())),
+		grpc.StreamInterceptor(chainStreamServer(ServerStreamAuth(key, genesis, guard), limiter.ServerStreamLimit())),
+		grpc.MaxSendMsgSize(limiter.maxResponseSize()))
+}
+
+// StartTLSService starts and returns gRPC server.
+// Connections are identified by mutual TLS, see NewTLSConfig().
+// Identified requests are throttled by limiter (nil is unlimited).
+func StartTLSService(bind string, key *common.PrivateKey, genesis hash.Hash, limiter *Limiter, svc NodeServer, log func(string, ...interface{}), listen network.ListenFunc) (
+	*grpc.Server, string) {
+	opts, err := TLSServerOptions(key, genesis, limiter)
+	if err != nil {
+		logger.Get().Fatal(err)
+	}
+
+	return startService(bind, svc, log, listen, opts...)
+}
+
+func startService(bind string, svc NodeServer, log func(string, ...interface{}), listen network.ListenFunc, opts ...grpc.ServerOption) (
+	*grpc.Server, string) {
+	opts = append(opts,
+		grpc.MaxRecvMsgSize(math.MaxInt32))
+	server := grpc.NewServer(opts...)
 	RegisterNodeServer(server, svc)
 
 	listener := listen(bind)
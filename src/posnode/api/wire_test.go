@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -58,6 +59,20 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 			return &wire.Event{}, nil
 		}).
 		AnyTimes()
+	svc.EXPECT().
+		GetEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(req *EventsRequest, stream Node_GetEventsServer) error {
+			ctx := stream.Context()
+			assert.Equal(t, from, GrpcPeerHost(ctx))
+			assert.Equal(t, clientID, GrpcPeerID(ctx))
+			for i := req.From; i <= req.To; i++ {
+				if err := stream.Send(&wire.Event{Index: i}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).
+		AnyTimes()
 	svc.EXPECT().
 		GetPeerInfo(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, req *PeerRequest) (*PeerInfo, error) {
@@ -68,7 +83,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		AnyTimes()
 
 	// server
-	server, addr := StartService(bind, serverKey, gen, svc, t.Logf, listen)
+	server, addr := StartService(bind, serverKey, gen, NewReplayGuard(time.Minute, 100), nil, svc, t.Logf, listen)
 	defer server.Stop()
 
 	t.Run("authorized", func(t *testing.T) {
@@ -77,6 +92,7 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		opts := append(opts,
 			grpc.WithInsecure(),
 			grpc.WithUnaryInterceptor(ClientAuth(clientKey, gen)),
+			grpc.WithStreamInterceptor(ClientStreamAuth(clientKey, gen)),
 		)
 		conn, err := grpc.DialContext(context.Background(), addr, opts...)
 		if err != nil {
@@ -113,6 +129,23 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		if !assert.Equal(serverID, *id3) {
 			return
 		}
+
+		// GetEvents() rpc
+		id4, ctx4 := ServerPeerID(nil)
+		stream, err := client.GetEvents(ctx4, &EventsRequest{From: 1, To: 3})
+		if !assert.NoError(err) {
+			return
+		}
+		for i := uint64(1); i <= 3; i++ {
+			e, err := stream.Recv()
+			if !assert.NoError(err) {
+				return
+			}
+			assert.Equal(i, e.Index)
+		}
+		if !assert.Equal(serverID, *id4) {
+			return
+		}
 	})
 
 	t.Run("unauthorized client", func(t *testing.T) {
@@ -156,6 +189,20 @@ func testGRPC(t *testing.T, bind, from string, listen network.ListenFunc, opts .
 		if !assert.Equal(hash.EmptyPeer, *id3) {
 			return
 		}
+
+		// GetEvents() rpc
+		id4, ctx4 := ServerPeerID(nil)
+		stream, err := client.GetEvents(ctx4, &EventsRequest{From: 1, To: 3})
+		if !assert.NoError(err) {
+			return
+		}
+		_, err = stream.Recv()
+		if !assert.Error(err) {
+			return
+		}
+		if !assert.Equal(hash.EmptyPeer, *id4) {
+			return
+		}
 	})
 
 	// TODO: test client with unauthorized server.
@@ -2,6 +2,7 @@ package posnode
 
 import (
 	"context"
+	"crypto/tls"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -18,18 +19,21 @@ type (
 	// connection wraps grpc.ClientConn
 	connection struct {
 		*grpc.ClientConn
-		addr    string
+		key     string
 		created time.Time
 		used    int
 	}
 
 	// connPool is connections to peers.
+	// Connection is made to the address and verified for the peer id.
 	connPool struct {
 		cache map[string]*connection
 		size  int
 
 		connectTimeout time.Duration
 		opts           []grpc.DialOption
+		tls            *tls.Config
+		genesis        hash.Hash
 
 		sync.RWMutex
 	}
@@ -48,17 +52,28 @@ func (n *Node) initClient() {
 		genesis = n.consensus.GetGenesisHash()
 	}
 
+	if n.conf.TLS {
+		config, err := api.NewTLSConfig(n.key, genesis)
+		if err != nil {
+			n.Fatal(err)
+		}
+		n.connPool.tls = config
+		n.connPool.genesis = genesis
+		return
+	}
+
 	n.connPool.opts = append(n.connPool.opts,
 		grpc.WithInsecure(),
-		grpc.WithUnaryInterceptor(api.ClientAuth(n.key, genesis)))
+		grpc.WithUnaryInterceptor(api.ClientAuth(n.key, genesis)),
+		grpc.WithStreamInterceptor(api.ClientStreamAuth(n.key, genesis)))
 }
 
 // ConnectTo connects to other node service.
 func (n *Node) ConnectTo(peer *Peer) (client api.NodeClient, free func(), fail func(error), err error) {
-	addr := n.NetAddrOf(peer.Host)
+	addr := peer.NetAddr(n.conf.Port)
 	n.Debugf("connect to %s", addr)
 
-	c, err := n.connPool.Get(addr)
+	c, err := n.connPool.Get(addr, peer.ID)
 	if err != nil {
 		err = errors.Wrapf(err, "connect to: %s", addr)
 		n.Warn(err)
@@ -85,19 +100,21 @@ func (n *Node) ConnectTo(peer *Peer) (client api.NodeClient, free func(), fail f
  * connectionPool utils:
  */
 
-func (cc *connPool) Get(addr string) (*connection, error) {
+func (cc *connPool) Get(addr string, id hash.Peer) (*connection, error) {
 	cc.Lock()
 	defer cc.Unlock()
 
-	conn := cc.cache[addr]
+	key := id.Hex() + "@" + addr
+	conn := cc.cache[key]
 	if conn == nil {
 		// make new
 		var err error
-		conn, err = cc.newConn(addr)
+		conn, err = cc.newConn(addr, id)
 		if err != nil {
 			return nil, err
 		}
-		cc.cache[addr] = conn
+		conn.key = key
+		cc.cache[key] = conn
 
 		if len(cc.cache) >= cc.size {
 			go cc.Clean()
@@ -118,9 +135,9 @@ func (cc *connPool) Release(c *connection, count bool, err error) {
 	}
 
 	// try to close if error now or before
-	if cached := cc.cache[c.addr]; err != nil || c != cached {
+	if cached := cc.cache[c.key]; err != nil || c != cached {
 		if c == cached {
-			delete(cc.cache, c.addr)
+			delete(cc.cache, c.key)
 		}
 		if c.used < 1 {
 			_ = c.Close()
@@ -144,24 +161,29 @@ func (cc *connPool) Clean() {
 	old := all[cc.size/2:]
 
 	for _, c := range old {
-		if cached := cc.cache[c.addr]; c == cached {
-			delete(cc.cache, c.addr)
+		if cached := cc.cache[c.key]; c == cached {
+			delete(cc.cache, c.key)
 		}
 	}
 }
 
-func (cc *connPool) newConn(addr string) (*connection, error) {
+// newConn dials address, TLS connection is made to the expected peer only.
+func (cc *connPool) newConn(addr string, id hash.Peer) (*connection, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), cc.connectTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, addr, append(cc.opts, grpc.WithBlock())...)
+	opts := append([]grpc.DialOption{grpc.WithBlock()}, cc.opts...)
+	if cc.tls != nil {
+		opts = append(opts, api.TLSDialOptions(cc.tls, cc.genesis, id)...)
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &connection{
 		ClientConn: conn,
-		addr:       addr,
 		created:    time.Now(),
 	}, nil
 }
@@ -3,6 +3,7 @@ package posnode
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -74,3 +75,32 @@ func TestClient(t *testing.T) {
 
 	// TODO: test the all situations.
 }
+
+func TestClientTLS(t *testing.T) {
+	assert := assert.New(t)
+
+	server := NewForTests("tls-server.fake", NewMemStore(), nil)
+	server.conf.TLS = true
+	server.initPeers()
+	server.StartService()
+	defer server.StopService()
+
+	node := NewForTests("tls-client.fake", NewMemStore(), nil)
+	node.conf.TLS = true
+	node.initPeers()
+
+	peer, err := node.PingHost(server.Host())
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(server.AsPeer(), peer)
+	assert.Equal(node.AsPeer(), server.store.GetPeer(node.ID))
+
+	plain := NewForTests("plain-client.fake", NewMemStore(), nil)
+	plain.conf.ConnectTimeout = time.Millisecond * 100
+	plain.conf.ClientTimeout = time.Millisecond * 100
+	plain.initPeers()
+
+	_, err = plain.PingHost(server.Host())
+	assert.Error(err)
+}
@@ -4,37 +4,97 @@ import (
 	"net"
 	"strconv"
 	"time"
+
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
 
 // Config is a set of nodes params.
 type Config struct {
-	EventParentsCount int // max count of event's parents (includes self-parent)
-	Port              int // default service port
+	EventParentsCount int             // max count of event's parents (includes self-parent)
+	EventMaxSize      int             // max size of serialized event in bytes
+	EventMaxTxns      int             // max count of event's transactions (internal and external)
+	EventMaxTxnSize   int             // max size of external transaction payload in bytes
+	EventLamportAhead inter.Timestamp // how far event lamport time may run ahead of its known parents
+	Parents           ParentStrategy  // how to choose event's parents (nil is StakeParents())
+	Port              int             // default service port
+	Advertise         []string        // external "host:port" addresses of node (e.g. NAT port mappings)
 
-	GossipThreads    int           // count of gossiping goroutines
+	GossipThreads    int           // min count of pull gossiping goroutines
+	GossipMaxThreads int           // max count of pull gossiping goroutines under catch-up
+	GossipIdle       time.Duration // pause between pull gossip rounds of each goroutine
+	AnnounceFanout   int           // count of top peers to push new events announcement to (0 is pull only)
 	EmitInterval     time.Duration // event emission interval
 	DiscoveryTimeout time.Duration // how often discovery should try to request
+	DiscoveryAlpha   int           // count of parallel requests of peers lookup
+	BucketSize       int           // max count of peers in routing bucket
+
+	TLS             bool          // use mutual TLS instead of request signs
+	ReplayWindow    time.Duration // max age of signed request (requests from the future are limited by a few seconds)
+	ReplayCacheSize int           // count of remembered nonces of signed requests
+	ConnectTimeout  time.Duration // how long dialer will for connection to be established
+	ClientTimeout   time.Duration // how long will gRPC client will wait for response
 
-	ConnectTimeout time.Duration // how long dialer will for connection to be established
-	ClientTimeout  time.Duration // how long will gRPC client will wait for response
+	StreamMaxSize int // max size of events in one stream, bytes
+	StreamBatch   int // count of streamed events verified at once
+
+	PeerRequestRate   float64 // requests per second served to each peer (0 is unlimited)
+	PeerRequestBurst  int     // max requests at once served to each peer
+	TotalRequestRate  float64 // requests per second served to all peers (0 is unlimited)
+	TotalRequestBurst int     // max requests at once served to all peers
+	PeerStreams       int     // max concurrent streams of each peer (0 is unlimited)
+	TotalStreams      int     // max concurrent streams of all peers (0 is unlimited)
+	ResponseMaxSize   int     // max size of response message, bytes
 
 	TopPeersCount int // peers hot cache size
+
+	BanScore        int64         // reputation score to ban peer temporary
+	BanForeverScore int64         // reputation score to ban peer forever
+	BanTimeout      time.Duration // temporary ban duration
+	BanInvalidData  uint64        // count of invalid data to ban peer temporary regardless of score (0 is off)
 }
 
 // DefaultConfig returns default config.
 func DefaultConfig() *Config {
 	return &Config{
 		EventParentsCount: 3,
+		EventMaxSize:      1024 * 1024,
+		EventMaxTxns:      1000,
+		EventMaxTxnSize:   64 * 1024,
+		EventLamportAhead: 1000,
+		Parents:           StakeParents(),
 		Port:              55555,
 
 		GossipThreads:    4,
+		GossipMaxThreads: 16,
+		GossipIdle:       5 * time.Second,
+		AnnounceFanout:   0,
 		EmitInterval:     10 * time.Second,
 		DiscoveryTimeout: 5 * time.Minute,
+		DiscoveryAlpha:   3,
+		BucketSize:       16,
 
-		ConnectTimeout: 15 * time.Second,
-		ClientTimeout:  15 * time.Second,
+		ReplayWindow:    time.Minute,
+		ReplayCacheSize: 100000,
+		ConnectTimeout:  15 * time.Second,
+		ClientTimeout:   15 * time.Second,
+
+		StreamMaxSize: 4 * 1024 * 1024,
+		StreamBatch:   64,
+
+		PeerRequestRate:   50,
+		PeerRequestBurst:  100,
+		TotalRequestRate:  1000,
+		TotalRequestBurst: 2000,
+		PeerStreams:       4,
+		TotalStreams:      64,
+		ResponseMaxSize:   8 * 1024 * 1024,
 
 		TopPeersCount: 10,
+
+		BanScore:        -300,
+		BanForeverScore: -1000,
+		BanTimeout:      time.Hour,
+		BanInvalidData:  3,
 	}
 }
 
@@ -1,15 +1,106 @@
 package posnode
 
 import (
+	"context"
+	"sync"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
 
 // Consensus is a consensus interface.
 type Consensus interface {
-	// PushEvent takes event for processing.
-	PushEvent(hash.Event)
+	// TryPushEvent takes event for processing, waits for room in queue until ctx is done.
+	TryPushEvent(context.Context, hash.Event) error
+	// Backpressure returns fill ratio [0..1] of events processing queue.
+	Backpressure() float64
+	// PushForkProof takes cheating evidence and known public key of the cheater for processing.
+	PushForkProof(*inter.ForkProof, *common.PublicKey)
+	// GetCheaters returns peers caught on forks.
+	GetCheaters() []hash.Peer
+	// GetForkProof returns cheating evidence of peer or nil.
+	GetForkProof(hash.Peer) *inter.ForkProof
 	// StakeOf returns stake of peer.
 	StakeOf(hash.Peer) uint64
+	// KnownRoots returns frame of event and creators of the frame roots known by event.
+	KnownRoots(hash.Event) (uint64, []hash.Peer)
 	// GetGenesisHash returns hash of genesis poset works with.
 	GetGenesisHash() hash.Hash
+	// GetTransactionCount returns count of accepted transactions of peer.
+	GetTransactionCount(hash.Peer) uint64
+	// GetValidatorKey returns registered public key of validator.
+	GetValidatorKey(hash.Peer) *common.PublicKey
+}
+
+// consensusQueue is an ordered backlog of saved events
+// the consensus had no room for.
+type consensusQueue struct {
+	backlog  []hash.Event
+	draining bool
+
+	sync.Mutex
+}
+
+// nowait is a done context to push without waiting.
+var nowait = func() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}()
+
+// pushToConsensus passes saved event to consensus without blocking.
+// If consensus queue is full, event is retried in order by background goroutine.
+func (n *Node) pushToConsensus(e hash.Event) {
+	if n.consensus == nil {
+		return
+	}
+
+	n.consensusQueue.Lock()
+	defer n.consensusQueue.Unlock()
+
+	// NOTE: events order matter, so the backlog goes first
+	if len(n.consensusQueue.backlog) < 1 && n.consensus.TryPushEvent(nowait, e) == nil {
+		return
+	}
+
+	n.consensusQueue.backlog = append(n.consensusQueue.backlog, e)
+	if !n.consensusQueue.draining {
+		n.consensusQueue.draining = true
+		go n.drainConsensusQueue()
+	}
+}
+
+// drainConsensusQueue retries to push backlog events until backlog is empty.
+func (n *Node) drainConsensusQueue() {
+	for {
+		n.consensusQueue.Lock()
+		if len(n.consensusQueue.backlog) < 1 {
+			n.consensusQueue.draining = false
+			n.consensusQueue.Unlock()
+			return
+		}
+		e := n.consensusQueue.backlog[0]
+		n.consensusQueue.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		err := n.consensus.TryPushEvent(ctx, e)
+		cancel()
+		if err != nil {
+			n.Warnf("consensus is busy, %d events are waiting", n.consensusBacklog())
+			continue
+		}
+
+		n.consensusQueue.Lock()
+		n.consensusQueue.backlog = n.consensusQueue.backlog[1:]
+		n.consensusQueue.Unlock()
+	}
+}
+
+// consensusBacklog returns count of events waiting for room in consensus queue.
+func (n *Node) consensusBacklog() int {
+	n.consensusQueue.Lock()
+	defer n.consensusQueue.Unlock()
+
+	return len(n.consensusQueue.backlog)
 }
@@ -32,7 +32,8 @@ type (
 
 // StartDiscovery starts single thread network discovery.
 // If there are no tasks for the discovery of unknown peers,
-// after idle time will try to discover one of builtin peers.
+// after idle time will lookup peers closest to self
+// (bootstraps from one of builtin peers if routing table is empty).
 func (n *Node) StartDiscovery() {
 	if n.discovery.done != nil {
 		return
@@ -50,10 +51,10 @@ func (n *Node) StartDiscovery() {
 			select {
 			case task := <-n.discovery.tasks:
 				n.AskPeerInfo(task.host, task.unknown)
+			case task := <-n.routing.checks:
+				n.checkBucket(task)
 			case <-time.After(discoveryIdle):
-				if host := n.NextBuiltInPeer(); host != "" {
-					n.AskPeerInfo(host, nil)
-				}
+				n.discoverNetwork()
 			case <-done:
 				return
 			}
@@ -124,8 +125,8 @@ func (n *Node) AskPeerInfo(host string, id *hash.Peer) {
 		return
 	}
 
-	if hash.PeerOfPubkeyBytes(info.PubKey) != hash.HexToPeer(info.ID) {
-		n.ConnectFail(peer, fmt.Errorf("bad PeerInfo response"))
+	if err := checkPeerInfo(info); err != nil {
+		n.ConnectFail(peer, fmt.Errorf("bad PeerInfo response: %s", err))
 		return
 	}
 
@@ -137,7 +138,7 @@ func (n *Node) AskPeerInfo(host string, id *hash.Peer) {
 
 	info.Host = host
 	peer = WireToPeer(info)
-	n.store.SetWirePeer(peer.ID, info)
+	n.savePeerInfo(info)
 	n.Debugf("discovered new peer %s with host %s", info.ID, info.Host)
 	n.ConnectOK(peer)
 }
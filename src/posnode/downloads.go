@@ -8,9 +8,11 @@ import (
 
 type (
 	downloads struct {
-		heights    map[hash.Peer]uint64
-		hashes     hash.Events
-		sync.Mutex // TODO: split to separates for heights and hashes
+		heights   map[hash.Peer]uint64
+		heightsMu sync.Mutex
+
+		hashes   hash.Events
+		hashesMu sync.Mutex
 	}
 
 	interval struct {
@@ -28,8 +30,8 @@ func (n *Node) initDownloads() {
 
 // lockFreeHeights returns start indexes of height free intervals and reserves their.
 func (n *Node) lockFreeHeights(want map[hash.Peer]uint64) map[hash.Peer]interval {
-	n.downloads.Lock()
-	defer n.downloads.Unlock()
+	n.downloads.heightsMu.Lock()
+	defer n.downloads.heightsMu.Unlock()
 
 	res := make(map[hash.Peer]interval, len(want))
 
@@ -51,8 +53,8 @@ func (n *Node) lockFreeHeights(want map[hash.Peer]uint64) map[hash.Peer]interval
 
 // unlockFreeHeights known peer height.
 func (n *Node) unlockFreeHeights(hh map[hash.Peer]interval) {
-	n.downloads.Lock()
-	defer n.downloads.Unlock()
+	n.downloads.heightsMu.Lock()
+	defer n.downloads.heightsMu.Unlock()
 
 	for creator, interval := range hh {
 		locked := n.downloads.heights[creator]
@@ -64,8 +66,8 @@ func (n *Node) unlockFreeHeights(hh map[hash.Peer]interval) {
 
 // lockNotDownloaded returns not downloaded yet from event list and reserves their.
 func (n *Node) lockNotDownloaded(events hash.Events) hash.Events {
-	n.downloads.Lock()
-	defer n.downloads.Unlock()
+	n.downloads.hashesMu.Lock()
+	defer n.downloads.hashesMu.Unlock()
 
 	res := hash.Events{}
 
@@ -87,8 +89,8 @@ func (n *Node) lockNotDownloaded(events hash.Events) hash.Events {
 
 // unlockDownloaded marks events are not downloading.
 func (n *Node) unlockDownloaded(events hash.Events) {
-	n.downloads.Lock()
-	defer n.downloads.Unlock()
+	n.downloads.hashesMu.Lock()
+	defer n.downloads.hashesMu.Unlock()
 
 	for e := range events {
 		delete(n.downloads.hashes, e)
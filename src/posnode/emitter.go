@@ -2,6 +2,7 @@ package posnode
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 type emitter struct {
 	internalTxns map[hash.Transaction]*inter.InternalTransaction
 	externalTxns [][]byte
+	lastNonce    uint64
 	done         chan struct{}
 
 	sync.RWMutex
@@ -51,29 +53,72 @@ func (n *Node) StopEventEmission() {
 }
 
 // AddInternalTxn takes internal transaction for new event.
+// Transaction index is a sender's nonce, so it should follow the previous one.
 func (n *Node) AddInternalTxn(tx inter.InternalTransaction) (hash.Transaction, error) {
-	// TODO: make index and check idempotency of tx here
-	idx := hash.FakeTransaction()
+	idx := inter.TransactionHashOf(n.ID, &tx)
 
 	if tx.Receiver == n.ID {
 		return hash.Transaction{}, fmt.Errorf("can not transafer to yourself")
 	}
 
-	if tx.Amount < 1 {
-		return hash.Transaction{}, fmt.Errorf("can not transfer zero amount")
+	if tx.Version > inter.InternalTxVersion {
+		return hash.Transaction{}, fmt.Errorf("unsupported transaction version %d", tx.Version)
 	}
 
-	if balance := n.consensus.StakeOf(n.ID); tx.Amount > balance {
-		return hash.Transaction{}, fmt.Errorf("insufficient funds %d to transfer %d", balance, tx.Amount)
+	switch kind := tx.ActualKind(); kind {
+	case inter.TransferTx, inter.DelegateTx:
+		if tx.Amount < 1 {
+			return hash.Transaction{}, fmt.Errorf("can not transfer zero amount")
+		}
+
+		if balance := n.consensus.StakeOf(n.ID); tx.Amount > balance {
+			return hash.Transaction{}, fmt.Errorf("insufficient funds %d to transfer %d", balance, tx.Amount)
+		}
+	case inter.UndelegateTx, inter.RedelegateTx:
+		if tx.Amount < 1 || tx.UntilBlock < 1 {
+			return hash.Transaction{}, fmt.Errorf("can not move zero delegation")
+		}
+		if kind == inter.RedelegateTx && (tx.Source.IsEmpty() || tx.Source == tx.Receiver) {
+			return hash.Transaction{}, fmt.Errorf("redelegation source should differ from receiver")
+		}
+	case inter.SlashTx:
+		if tx.Amount != 0 || tx.UntilBlock != 0 {
+			return hash.Transaction{}, fmt.Errorf("slashing has no amount")
+		}
+	case inter.ValidatorRegistrationTx:
+		if tx.Version < 1 {
+			return hash.Transaction{}, fmt.Errorf("validator registration requires version %d", inter.InternalTxVersion)
+		}
+		if hash.PeerOfPubkeyBytes(tx.PubKey) != n.ID {
+			return hash.Transaction{}, fmt.Errorf("can not register key of other peer")
+		}
+	default:
+		return hash.Transaction{}, fmt.Errorf("unknown transaction kind %s", kind)
 	}
 
 	n.emitter.Lock()
 	defer n.emitter.Unlock()
 
+	if _, ok := n.emitter.internalTxns[idx]; ok {
+		return hash.Transaction{}, fmt.Errorf("transaction %s is added already", idx.Hex())
+	}
+
+	last := n.consensus.GetTransactionCount(n.ID)
+	if last < n.emitter.lastNonce {
+		last = n.emitter.lastNonce
+	}
+	if tx.Index <= last {
+		return hash.Transaction{}, fmt.Errorf("nonce %d is used already", tx.Index)
+	}
+	if tx.Index > last+1 {
+		return hash.Transaction{}, fmt.Errorf("nonce %d is out of order, expected %d", tx.Index, last+1)
+	}
+
 	if n.emitter.internalTxns == nil {
 		n.emitter.internalTxns = make(map[hash.Transaction]*inter.InternalTransaction)
 	}
 	n.emitter.internalTxns[idx] = &tx
+	n.emitter.lastNonce = tx.Index
 	return idx, nil
 }
 
@@ -113,7 +158,7 @@ func (n *Node) EmitEvent() *inter.Event {
 	}
 
 	for i := 1; i < n.conf.EventParentsCount; i++ {
-		p := n.popBestParent()
+		p := n.popBestParent(parents)
 		if p == nil {
 			break
 		}
@@ -133,6 +178,10 @@ func (n *Node) EmitEvent() *inter.Event {
 		internalTxns = append(internalTxns, txn)
 	}
 	n.emitter.internalTxns = nil
+	// nonce order
+	sort.Slice(internalTxns, func(i, j int) bool {
+		return internalTxns[i].Index < internalTxns[j].Index
+	})
 
 	externalTxns, n.emitter.externalTxns = n.emitter.externalTxns, nil
 
@@ -19,6 +19,10 @@ func TestAddInternalTxn(t *testing.T) {
 		StakeOf(gomock.Any()).
 		Return(uint64(2000)).
 		AnyTimes()
+	consensus.EXPECT().
+		GetTransactionCount(gomock.Any()).
+		Return(uint64(0)).
+		AnyTimes()
 
 	node := NewForTests("fake", nil, consensus)
 	peer := hash.FakePeer()
@@ -32,12 +36,11 @@ func TestAddInternalTxn(t *testing.T) {
 			Receiver: peer,
 		}
 
-		_, err := node.AddInternalTxn(tx)
+		h, err := node.AddInternalTxn(tx)
 		if !assert.NoError(err) {
 			return
 		}
-		// TODO: check when implemented
-		//assert.Equal(expect, h.Hex())
+		assert.Equal(inter.TransactionHashOf(node.ID, &tx), h)
 	})
 
 	t.Run("very 2nd add", func(t *testing.T) {
@@ -49,12 +52,65 @@ func TestAddInternalTxn(t *testing.T) {
 			Receiver: peer,
 		}
 
-		_, err := node.AddInternalTxn(tx)
+		h, err := node.AddInternalTxn(tx)
 		if !assert.NoError(err) {
 			return
 		}
-		// TODO: check when implemented
-		//assert.Equal(expect, h.Hex())
+		assert.Equal(inter.TransactionHashOf(node.ID, &tx), h)
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:    2,
+			Amount:   1000,
+			Receiver: peer,
+		}
+
+		_, err := node.AddInternalTxn(tx)
+		assert.Error(err)
+	})
+
+	t.Run("slashing with amount", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:    3,
+			Amount:   1000,
+			Receiver: peer,
+			Kind:     inter.SlashTx,
+		}
+
+		_, err := node.AddInternalTxn(tx)
+		assert.Error(err)
+	})
+
+	t.Run("registration of other key", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:   3,
+			Kind:    inter.ValidatorRegistrationTx,
+			PubKey:  peer.Bytes(),
+			Version: inter.InternalTxVersion,
+		}
+
+		_, err := node.AddInternalTxn(tx)
+		assert.Error(err)
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:    4,
+			Amount:   1000,
+			Receiver: peer,
+		}
+
+		_, err := node.AddInternalTxn(tx)
+		assert.Error(err)
 	})
 }
 
@@ -0,0 +1,80 @@
+package posnode
+
+import (
+	"context"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// knownCheaters returns hex ids of peers with fork proofs accepted by consensus.
+func (n *Node) knownCheaters() []string {
+	if n.consensus == nil {
+		return nil
+	}
+
+	cheaters := n.consensus.GetCheaters()
+	res := make([]string, len(cheaters))
+	for i, id := range cheaters {
+		res[i] = id.Hex()
+	}
+	return res
+}
+
+// unknownCheaters returns cheaters the consensus has no fork proofs of.
+func (n *Node) unknownCheaters(hexes []string) []hash.Peer {
+	if n.consensus == nil {
+		return nil
+	}
+
+	var res []hash.Peer
+	for _, hex := range hexes {
+		id := hash.HexToPeer(hex)
+		if n.consensus.GetForkProof(id) == nil {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// downloadForkProofs downloads, verifies and takes fork proofs of cheaters.
+func (n *Node) downloadForkProofs(client api.NodeClient, peer *Peer, cheaters []hash.Peer) {
+	for _, id := range cheaters {
+		proof, err := n.downloadForkProof(client, peer, id)
+		if err != nil {
+			n.Warnf("download fork proof error: %s", err.Error())
+			return
+		}
+
+		if proof == nil || proof.First == nil || proof.Creator() != id {
+			n.Warnf("peer %s sent wrong fork proof of %s", peer.ID.String(), id.String())
+			continue
+		}
+		key := n.creatorKey(id)
+		if key == nil || !proof.Verify(key) {
+			n.Warnf("peer %s sent invalid fork proof of %s", peer.ID.String(), id.String())
+			continue
+		}
+
+		n.Warnf("fork of %s is reported by %s", id.String(), peer.ID.String())
+		n.BanPeer(id, "fork")
+		n.consensus.PushForkProof(proof, key)
+	}
+}
+
+func (n *Node) downloadForkProof(client api.NodeClient, peer *Peer, id hash.Peer) (*inter.ForkProof, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	req := &api.PeerRequest{
+		PeerID: id.Hex(),
+	}
+	w, err := client.GetForkProof(ctx, req)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return nil, err
+	}
+
+	return inter.WireToForkProof(w), nil
+}
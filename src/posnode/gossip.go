@@ -2,36 +2,74 @@ package posnode
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
 const (
-	gossipIdle = time.Second * 5
+	gossipPressureHigh = 0.8 // consensus queue fill to pause downloads
+	gossipPressureLow  = 0.2 // consensus queue fill to speed up gossip
+	gossipCongestion   = 2   // peer latency growth to slow down gossip
+	gossipPressurePoll = 10 * time.Millisecond
 )
 
-// gossip is a pool of gossiping processes.
-type gossip struct {
-	tickets chan struct{}
+// ErrConsensusBusy is a reason to pause downloads.
+var ErrConsensusBusy = errors.New("consensus is overloaded")
 
-	sync.Mutex
-}
+type (
+	// gossip is a pool of gossiping processes.
+	gossip struct {
+		tickets  chan struct{}
+		threads  int // current concurrency
+		issued   int // tickets in use or in the pool
+		min, max int
 
-func (g *gossip) freeTicket() {
+		sync.Mutex
+	}
+
+	// syncResult is a feedback of gossip round.
+	syncResult struct {
+		progress  bool // there were events to download
+		congested bool // peer responded much slower than usual
+	}
+)
+
+func (g *gossip) freeTicket(tickets chan struct{}) {
 	g.Lock()
 	defer g.Unlock()
 
-	if g.tickets != nil {
+	if g.tickets != tickets {
+		return
+	}
+	if g.issued > g.threads {
+		g.issued--
+		return
+	}
+	g.tickets <- struct{}{}
+}
+
+// setThreads changes concurrency, extra tickets are withdrawn when freed.
+// It is not safe for concurrent use.
+func (g *gossip) setThreads(threads int) {
+	g.threads = threads
+	for g.issued < g.threads {
+		g.issued++
 		g.tickets <- struct{}{}
 	}
 }
 
 // StartGossip starts gossiping.
+// Concurrency starts from threads and grows up to Config.GossipMaxThreads under catch-up.
 func (n *Node) StartGossip(threads int) {
 	n.gossip.Lock()
 	defer n.gossip.Unlock()
@@ -42,16 +80,31 @@ func (n *Node) StartGossip(threads int) {
 
 	n.initPeers()
 
-	n.gossip.tickets = make(chan struct{}, threads)
-	for i := 0; i < threads; i++ {
-		n.gossip.tickets <- struct{}{}
+	n.gossip.min = threads
+	n.gossip.max = n.conf.GossipMaxThreads
+	if n.gossip.max < threads {
+		n.gossip.max = threads
 	}
+	n.gossip.issued = 0
+	n.gossip.tickets = make(chan struct{}, n.gossip.max)
+	n.gossip.setThreads(threads)
 
 	go n.gossiping(n.gossip.tickets)
 
 	n.Info("gossip started")
 }
 
+// GossipThreads returns current count of gossiping goroutines.
+func (n *Node) GossipThreads() int {
+	n.gossip.Lock()
+	defer n.gossip.Unlock()
+
+	if n.gossip.tickets == nil {
+		return 0
+	}
+	return n.gossip.threads
+}
+
 // StopGossip stops gossiping.
 func (n *Node) StopGossip() {
 	n.gossip.Lock()
@@ -71,32 +124,96 @@ func (n *Node) StopGossip() {
 func (n *Node) gossiping(tickets chan struct{}) {
 	for range tickets {
 		go func() {
-			defer n.gossip.freeTicket()
+			defer n.gossip.freeTicket(tickets)
 			peer := n.NextForGossip()
 			if peer != nil {
 				defer n.FreePeer(peer)
-				n.syncWithPeer(peer)
+				res := n.syncWithPeer(peer)
+				n.adaptGossip(tickets, res)
 			} else {
 				n.Warn("no candidate for gossip")
 			}
-			time.Sleep(gossipIdle)
+			time.Sleep(n.conf.GossipIdle)
 		}()
 	}
 
 }
 
-func (n *Node) syncWithPeer(peer *Peer) {
+// adaptGossip tunes concurrency by gossip round result and consensus queue:
+// it grows while node is catching up and shrinks if consensus or peers are overloaded.
+func (n *Node) adaptGossip(tickets chan struct{}, res syncResult) {
+	pressure := n.consensusPressure()
+
+	n.gossip.Lock()
+	defer n.gossip.Unlock()
+
+	if n.gossip.tickets != tickets {
+		return
+	}
+
+	threads := n.gossip.threads
+	switch {
+	case pressure >= gossipPressureHigh:
+		threads /= 2
+	case res.congested, !res.progress:
+		threads--
+	case pressure <= gossipPressureLow:
+		threads++
+	}
+
+	if threads < n.gossip.min {
+		threads = n.gossip.min
+	}
+	if threads > n.gossip.max {
+		threads = n.gossip.max
+	}
+	if threads != n.gossip.threads {
+		n.Debugf("gossip threads: %d", threads)
+		n.gossip.setThreads(threads)
+	}
+}
+
+// consensusPressure returns fill ratio of consensus queue.
+// Queue is full while there are events waiting for room in it.
+func (n *Node) consensusPressure() float64 {
+	if n.consensus == nil {
+		return 0
+	}
+	if n.consensusBacklog() > 0 {
+		return 1
+	}
+	return n.consensus.Backpressure()
+}
+
+// waitConsensus pauses downloads while consensus queue is overloaded.
+// Returns ErrConsensusBusy if it is still overloaded after ClientTimeout.
+func (n *Node) waitConsensus() error {
+	deadline := time.Now().Add(n.conf.ClientTimeout)
+	for n.consensusPressure() >= gossipPressureHigh {
+		if time.Now().After(deadline) {
+			return ErrConsensusBusy
+		}
+		time.Sleep(gossipPressurePoll)
+	}
+	return nil
+}
+
+func (n *Node) syncWithPeer(peer *Peer) (res syncResult) {
 	client, free, fail, err := n.ConnectTo(peer)
 	if err != nil {
 		return
 	}
 	defer free()
 
-	unknowns, err := n.compareKnownEvents(client, peer)
+	usual := n.PeerReputation(peer.ID).Latency
+	start := time.Now()
+	unknowns, cheaters, err := n.compareKnownEvents(client, peer)
 	if err != nil {
 		fail(err)
 		return
 	}
+	n.downloadForkProofs(client, peer, cheaters)
+	res.congested = usual > 0 && time.Since(start) > gossipCongestion*usual
 	if unknowns == nil {
 		return
 	}
@@ -106,25 +223,19 @@ func (n *Node) syncWithPeer(peer *Peer) {
 
 	toDownload := n.lockFreeHeights(unknowns)
 	defer n.unlockFreeHeights(toDownload)
+	res.progress = len(toDownload) > 0
 
 	for creator, interval := range toDownload {
-		req := &api.EventRequest{
-			PeerID: creator.Hex(),
-		}
-		for i := interval.from; i <= interval.to; i++ {
-			req.Index = i
-
-			event, err := n.downloadEvent(client, peer, req)
-			if err != nil {
-				fail(err)
-				return
-			}
-			if event == nil {
-				return
-			}
+		peers2discovery[creator] = struct{}{}
 
-			peers2discovery[creator] = struct{}{}
-			parents.Add(event.Parents.Slice()...)
+		got, err := n.downloadEvents(client, peer, creator, interval)
+		parents.Add(got.Slice()...)
+		if err == ErrUnknownCreator || err == ErrConsensusBusy {
+			return
+		}
+		if err != nil {
+			fail(err)
+			return
 		}
 	}
 	n.ConnectOK(peer)
@@ -138,6 +249,8 @@ func (n *Node) syncWithPeer(peer *Peer) {
 
 	// Clean outdated data about peers.
 	n.trimHosts(n.conf.TopPeersCount*4, n.conf.TopPeersCount*3)
+
+	return
 }
 
 func (n *Node) checkParents(client api.NodeClient, peer *Peer, parents hash.Events) {
@@ -165,7 +278,8 @@ func (n *Node) checkParents(client api.NodeClient, peer *Peer, parents hash.Even
 	}
 }
 
-func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.Peer]uint64, error) {
+// compareKnownEvents returns unknown events heights and cheaters the peer knows.
+func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.Peer]uint64, []hash.Peer, error) {
 	knowns := n.knownEvents()
 
 	req := &api.KnownEvents{
@@ -180,14 +294,18 @@ func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.P
 
 	id, ctx := api.ServerPeerID(ctx)
 
+	start := time.Now()
 	resp, err := client.SyncEvents(ctx, req)
 	if err != nil {
 		n.ConnectFail(peer, err)
-		return nil, err
+		return nil, nil, err
 	}
+	n.peerLatency(peer, time.Since(start))
 
 	if *id != peer.ID {
-		// TODO: skip or continue gossiping with peer id ?
+		err = fmt.Errorf("peer %s answered as %s", peer.ID.String(), id.String())
+		n.ConnectFail(peer, err)
+		return nil, nil, err
 	}
 
 	res := make(map[hash.Peer]uint64, len(resp.Lasts))
@@ -196,11 +314,147 @@ func (n *Node) compareKnownEvents(client api.NodeClient, peer *Peer) (map[hash.P
 	}
 
 	n.ConnectOK(peer)
-	return res, nil
+	return res, n.unknownCheaters(resp.Cheaters), nil
+}
+
+// downloadEvents downloads, verifies and takes creator's events from interval.
+// It uses events stream or unary requests if peer does not support streaming.
+// Returns parents of taken events.
+func (n *Node) downloadEvents(client api.NodeClient, peer *Peer, creator hash.Peer, i interval) (hash.Events, error) {
+	parents := hash.Events{}
+
+	batch := make([]*inter.Event, 0, n.conf.StreamBatch)
+	flush := func() error {
+		errs := n.verifyEvents(batch)
+		for j, event := range batch {
+			if err := n.acceptEvent(peer, event, errs[j]); err != nil {
+				return err
+			}
+			parents.Add(event.Parents.Slice()...)
+		}
+		batch = batch[:0]
+		return nil
+	}
+	push := func(event *inter.Event) error {
+		batch = append(batch, event)
+		if len(batch) < n.conf.StreamBatch {
+			return nil
+		}
+		return flush()
+	}
+
+	next := i.from
+	for next <= i.to {
+		if err := n.waitConsensus(); err != nil {
+			return parents, err
+		}
+
+		req := &api.EventsRequest{
+			PeerID: creator.Hex(),
+			From:   next,
+			To:     i.to,
+		}
+		got, err := n.streamEvents(client, peer, req, push)
+		next += got
+		if got == 0 && status.Code(err) == codes.Unimplemented {
+			n.Debugf("peer %s does not support events stream", peer.ID.String())
+			err = n.requestEvents(client, peer, creator, interval{next, i.to}, push)
+			next = i.to + 1
+		}
+		if err != nil {
+			return parents, err
+		}
+		if got == 0 {
+			break
+		}
+	}
+
+	return parents, flush()
+}
+
+// streamEvents downloads events by one stream, the stream is flow controlled by push().
+// Returns count of pushed events.
+func (n *Node) streamEvents(client api.NodeClient, peer *Peer, req *api.EventsRequest, push func(*inter.Event) error) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	n.Info("download events")
+
+	stream, err := client.GetEvents(ctx, req)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return 0, err
+	}
+
+	var count uint64
+	for {
+		w, err := stream.Recv()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			if status.Code(err) != codes.Unimplemented {
+				n.ConnectFail(peer, err)
+			}
+			return count, err
+		}
+
+		if w.Creator != req.PeerID || w.Index != req.From+count {
+			err = fmt.Errorf("bad GetEvents() response")
+			n.ConnectFail(peer, err)
+			return count, err
+		}
+
+		if err = push(inter.WireToEvent(w)); err != nil {
+			return count, err
+		}
+		count++
+	}
 }
 
-// downloadEvent downloads event.
+// requestEvents downloads events by unary requests.
+func (n *Node) requestEvents(client api.NodeClient, peer *Peer, creator hash.Peer, i interval, push func(*inter.Event) error) error {
+	req := &api.EventRequest{
+		PeerID: creator.Hex(),
+	}
+	for idx := i.from; idx <= i.to; idx++ {
+		req.Index = idx
+
+		event, err := n.fetchEvent(client, peer, req)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return nil
+		}
+
+		if err = push(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downloadEvent downloads, verifies and takes event.
 func (n *Node) downloadEvent(client api.NodeClient, peer *Peer, req *api.EventRequest) (*inter.Event, error) {
+	event, err := n.fetchEvent(client, peer, req)
+	if event == nil || err != nil {
+		return nil, err
+	}
+
+	err = n.acceptEvent(peer, event, n.verifyEvent(event))
+	if err == ErrUnknownCreator {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// fetchEvent requests event from peer.
+func (n *Node) fetchEvent(client api.NodeClient, peer *Peer, req *api.EventRequest) (*inter.Event, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
 	defer cancel()
 
@@ -215,7 +469,9 @@ func (n *Node) downloadEvent(client api.NodeClient, peer *Peer, req *api.EventRe
 	}
 
 	if *id != peer.ID {
-		// TODO: skip or continue gossiping with peer id ?
+		err = fmt.Errorf("peer %s answered as %s", peer.ID.String(), id.String())
+		n.ConnectFail(peer, err)
+		return nil, err
 	}
 
 	if req.Hash == nil {
@@ -225,22 +481,28 @@ func (n *Node) downloadEvent(client api.NodeClient, peer *Peer, req *api.EventRe
 		}
 	}
 
-	event := inter.WireToEvent(w)
+	return inter.WireToEvent(w), nil
+}
 
-	// check event sign
-	creator := n.store.GetPeer(event.Creator)
-	if creator == nil {
-		return nil, nil
+// acceptEvent takes event from peer into processing if it is verified and valid.
+func (n *Node) acceptEvent(peer *Peer, event *inter.Event, verifyErr error) error {
+	switch verifyErr {
+	case nil:
+	case ErrUnknownCreator:
+		return verifyErr
+	default:
+		n.InvalidEvent(peer, verifyErr)
+		n.ConnectFail(peer, fmt.Errorf("falsity GetEvent() response: %s", verifyErr))
+		return verifyErr
 	}
-	if !event.Verify(creator.PubKey) {
-		err = fmt.Errorf("falsity GetEvent() response")
-		n.ConnectFail(peer, err)
-		return nil, err
+
+	if err := n.validateEvent(peer, event); err != nil {
+		return err
 	}
 
+	n.usefulEvents(peer, 1)
 	n.onNewEvent(event)
-
-	return event, nil
+	return nil
 }
 
 // knownEventsReq makes request struct with event heights of top peers.
@@ -262,23 +524,55 @@ func (n *Node) knownEvents() map[hash.Peer]uint64 {
  */
 
 // gossipEvaluation implements sort.Interface.
-type gossipEvaluation Node
+// Peer reputations are evaluated once before sorting.
+type gossipEvaluation struct {
+	peers  *peers
+	banned []bool
+	scores []int64
+}
+
+// newGossipEvaluation evaluates top peers.
+// It should be called under peers lock.
+func (n *Node) newGossipEvaluation() *gossipEvaluation {
+	e := &gossipEvaluation{
+		peers:  &n.peers,
+		banned: make([]bool, len(n.peers.top)),
+		scores: make([]int64, len(n.peers.top)),
+	}
+	for i, id := range n.peers.top {
+		r := n.PeerReputation(id)
+		e.banned[i] = r.IsBanned()
+		e.scores[i] = r.Score()
+	}
+	return e
+}
 
 // Len is the number of elements in the collection.
-func (n *gossipEvaluation) Len() int {
-	return len(n.peers.top)
+func (e *gossipEvaluation) Len() int {
+	return len(e.peers.top)
 }
 
 // Swap swaps the elements with indexes i and j.
-func (n *gossipEvaluation) Swap(i, j int) {
-	n.peers.top[i], n.peers.top[j] = n.peers.top[j], n.peers.top[i]
+func (e *gossipEvaluation) Swap(i, j int) {
+	e.peers.top[i], e.peers.top[j] = e.peers.top[j], e.peers.top[i]
+	e.banned[i], e.banned[j] = e.banned[j], e.banned[i]
+	e.scores[i], e.scores[j] = e.scores[j], e.scores[i]
 }
 
 // Less reports whether the element with
 // index i should sort before the element with index j.
-func (n *gossipEvaluation) Less(i, j int) bool {
-	a := n.peers.attrByID(n.peers.top[i]).Host
-	b := n.peers.attrByID(n.peers.top[j]).Host
+func (e *gossipEvaluation) Less(i, j int) bool {
+	if e.banned[i] != e.banned[j] {
+		return e.banned[j]
+	}
+	if e.scores[i] != e.scores[j] {
+		return e.scores[i] > e.scores[j]
+	}
+
+	x := e.peers.attrByID(e.peers.top[i])
+	y := e.peers.attrByID(e.peers.top[j])
+
+	a, b := x.Host, y.Host
 
 	if a.LastSuccess.After(a.LastFail) && !b.LastSuccess.After(b.LastFail) {
 		return true
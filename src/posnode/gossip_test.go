@@ -2,7 +2,9 @@ package posnode
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
@@ -156,7 +158,7 @@ func TestMissingParents(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		unknowns, err := node2.compareKnownEvents(client, peer)
+		unknowns, _, err := node2.compareKnownEvents(client, peer)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -304,3 +306,57 @@ func TestPeerPriority(t *testing.T) {
 			"should select peer1 as first not busy in top peer")
 	})
 }
+
+func TestGossipAdaptation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pressure := 0.0
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		Backpressure().
+		DoAndReturn(func() float64 { return pressure }).
+		AnyTimes()
+	consensus.EXPECT().
+		GetGenesisHash().
+		Return(hash.Hash{}).
+		AnyTimes()
+
+	node := NewForTests("node0", NewMemStore(), consensus)
+	node.conf.GossipIdle = time.Hour
+	node.conf.GossipMaxThreads = 4
+	node.conf.ClientTimeout = 50 * time.Millisecond
+	node.StartGossip(1)
+	defer node.StopGossip()
+
+	adapt := func(res syncResult) int {
+		node.adaptGossip(node.gossip.tickets, res)
+		return node.GossipThreads()
+	}
+
+	t.Run("catch-up", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(1, node.GossipThreads())
+		assert.Equal(2, adapt(syncResult{progress: true}))
+		assert.Equal(3, adapt(syncResult{progress: true}))
+		assert.Equal(4, adapt(syncResult{progress: true}))
+		assert.Equal(4, adapt(syncResult{progress: true}), "max")
+
+		pressure = 0.5
+		assert.Equal(4, adapt(syncResult{progress: true}), "moderate pressure")
+		assert.Equal(3, adapt(syncResult{progress: true, congested: true}), "slow peer")
+	})
+
+	t.Run("backpressure", func(t *testing.T) {
+		assert := assert.New(t)
+
+		pressure = 1.0
+		assert.Equal(1, adapt(syncResult{progress: true}))
+		assert.Equal(ErrConsensusBusy, node.waitConsensus())
+
+		pressure = 0.0
+		assert.NoError(node.waitConsensus())
+		assert.Equal(1, adapt(syncResult{}), "min")
+	})
+}
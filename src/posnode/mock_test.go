@@ -5,7 +5,10 @@
 package posnode
 
 import (
+	context "context"
+	common "github.com/Fantom-foundation/go-lachesis/src/common"
 	hash "github.com/Fantom-foundation/go-lachesis/src/hash"
+	inter "github.com/Fantom-foundation/go-lachesis/src/inter"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -33,16 +36,72 @@ func (m *MockConsensus) EXPECT() *MockConsensusMockRecorder {
 	return m.recorder
 }
 
-// PushEvent mocks base method
-func (m *MockConsensus) PushEvent(arg0 hash.Event) {
+// TryPushEvent mocks base method
+func (m *MockConsensus) TryPushEvent(arg0 context.Context, arg1 hash.Event) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PushEvent", arg0)
+	ret := m.ctrl.Call(m, "TryPushEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TryPushEvent indicates an expected call of TryPushEvent
+func (mr *MockConsensusMockRecorder) TryPushEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryPushEvent", reflect.TypeOf((*MockConsensus)(nil).TryPushEvent), arg0, arg1)
+}
+
+// Backpressure mocks base method
+func (m *MockConsensus) Backpressure() float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backpressure")
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// Backpressure indicates an expected call of Backpressure
+func (mr *MockConsensusMockRecorder) Backpressure() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backpressure", reflect.TypeOf((*MockConsensus)(nil).Backpressure))
+}
+
+// PushForkProof mocks base method
+func (m *MockConsensus) PushForkProof(arg0 *inter.ForkProof, arg1 *common.PublicKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PushForkProof", arg0, arg1)
+}
+
+// PushForkProof indicates an expected call of PushForkProof
+func (mr *MockConsensusMockRecorder) PushForkProof(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushForkProof", reflect.TypeOf((*MockConsensus)(nil).PushForkProof), arg0, arg1)
+}
+
+// GetCheaters mocks base method
+func (m *MockConsensus) GetCheaters() []hash.Peer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheaters")
+	ret0, _ := ret[0].([]hash.Peer)
+	return ret0
+}
+
+// GetCheaters indicates an expected call of GetCheaters
+func (mr *MockConsensusMockRecorder) GetCheaters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheaters", reflect.TypeOf((*MockConsensus)(nil).GetCheaters))
+}
+
+// GetForkProof mocks base method
+func (m *MockConsensus) GetForkProof(arg0 hash.Peer) *inter.ForkProof {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForkProof", arg0)
+	ret0, _ := ret[0].(*inter.ForkProof)
+	return ret0
 }
 
-// PushEvent indicates an expected call of PushEvent
-func (mr *MockConsensusMockRecorder) PushEvent(arg0 interface{}) *gomock.Call {
+// GetForkProof indicates an expected call of GetForkProof
+func (mr *MockConsensusMockRecorder) GetForkProof(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushEvent", reflect.TypeOf((*MockConsensus)(nil).PushEvent), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForkProof", reflect.TypeOf((*MockConsensus)(nil).GetForkProof), arg0)
 }
 
 // StakeOf mocks base method
@@ -59,6 +118,21 @@ func (mr *MockConsensusMockRecorder) StakeOf(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StakeOf", reflect.TypeOf((*MockConsensus)(nil).StakeOf), arg0)
 }
 
+// KnownRoots mocks base method
+func (m *MockConsensus) KnownRoots(arg0 hash.Event) (uint64, []hash.Peer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KnownRoots", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].([]hash.Peer)
+	return ret0, ret1
+}
+
+// KnownRoots indicates an expected call of KnownRoots
+func (mr *MockConsensusMockRecorder) KnownRoots(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnownRoots", reflect.TypeOf((*MockConsensus)(nil).KnownRoots), arg0)
+}
+
 // GetGenesisHash mocks base method
 func (m *MockConsensus) GetGenesisHash() hash.Hash {
 	m.ctrl.T.Helper()
@@ -72,3 +146,31 @@ func (mr *MockConsensusMockRecorder) GetGenesisHash() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGenesisHash", reflect.TypeOf((*MockConsensus)(nil).GetGenesisHash))
 }
+
+// GetTransactionCount mocks base method
+func (m *MockConsensus) GetTransactionCount(arg0 hash.Peer) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionCount", arg0)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetTransactionCount indicates an expected call of GetTransactionCount
+func (mr *MockConsensusMockRecorder) GetTransactionCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionCount", reflect.TypeOf((*MockConsensus)(nil).GetTransactionCount), arg0)
+}
+
+// GetValidatorKey mocks base method
+func (m *MockConsensus) GetValidatorKey(arg0 hash.Peer) *common.PublicKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorKey", arg0)
+	ret0, _ := ret[0].(*common.PublicKey)
+	return ret0
+}
+
+// GetValidatorKey indicates an expected call of GetValidatorKey
+func (mr *MockConsensusMockRecorder) GetValidatorKey(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorKey", reflect.TypeOf((*MockConsensus)(nil).GetValidatorKey), arg0)
+}
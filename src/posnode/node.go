@@ -34,7 +34,14 @@ type Node struct {
 	gossip
 	downloads
 	discovery
+	announcement
 	builtin
+	validation
+	verification
+	reputations
+	routing
+	advertising
+	consensusQueue
 
 	logger.Instance
 }
@@ -59,7 +66,7 @@ func New(host string, key *common.PrivateKey, s *Store, c Consensus, conf *Confi
 		host:      host,
 		conf:      *conf,
 
-		service:  service{listen, nil},
+		service:  service{listen: listen},
 		connPool: connPool{opts: opts},
 
 		Instance: logger.MakeInstance(),
@@ -95,13 +102,36 @@ func (n *Node) saveNewEvent(e *inter.Event) {
 	n.Debugf("save new event")
 
 	n.store.SetEvent(e)
+
+	// NOTE: the first event keeps creator's index, the second one is a fork
+	// and is kept for the proof only: not a parent, not announced, not in consensus.
+	if prev := n.store.GetEventHash(e.Creator, e.Index); prev != nil && *prev != e.Hash() {
+		n.onFork(n.store.GetEvent(*prev), e)
+		return
+	}
 	n.store.SetEventHash(e.Creator, e.Index, e.Hash())
 	n.store.SetPeerHeight(e.Creator, e.Index)
 
 	n.pushPotentialParent(e)
+	n.announceEvent(e.Hash())
+
+	n.pushToConsensus(e.Hash())
+}
+
+// onFork handles events of the same creator with the same index.
+// It is not safe for concurrent use.
+func (n *Node) onFork(first, second *inter.Event) {
+	proof := inter.NewForkProof(first, second)
+	if proof == nil {
+		return
+	}
+
+	creator := proof.Creator()
+	n.Warnf("fork of %s detected: %s and %s", creator.String(), first.Hash().String(), second.Hash().String())
+	n.BanPeer(creator, "fork")
 
 	if n.consensus != nil {
-		n.consensus.PushEvent(e.Hash())
+		n.consensus.PushForkProof(proof, n.creatorKey(creator))
 	}
 }
 
@@ -110,12 +140,14 @@ func (n *Node) Start() {
 	n.StartService()
 	n.StartDiscovery()
 	n.StartGossip(n.conf.GossipThreads)
+	n.StartAnnouncement()
 	n.StartEventEmission()
 }
 
 // Stop stops all node services.
 func (n *Node) Stop() {
 	n.StopEventEmission()
+	n.StopAnnouncement()
 	n.StopGossip()
 	n.StopDiscovery()
 	n.StopService()
@@ -138,6 +170,7 @@ func (n *Node) AsPeer() *Peer {
 		ID:     n.ID,
 		PubKey: n.pub,
 		Host:   n.host,
+		Addrs:  n.selfAddrs(),
 	}
 }
 
@@ -4,6 +4,18 @@ package posnode
 //go:generate mockgen -package=posnode -source=consensus.go -destination=mock_test.go Consensus
 
 import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/network"
 )
 
@@ -15,3 +27,198 @@ func NewForTests(host string, s *Store, c Consensus) *Node {
 	}
 	return n
 }
+
+func TestForkDetection(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := NewMemStore()
+	consensus := NewMockConsensus(ctrl)
+	node := NewForTests("fake", store, consensus)
+
+	creator := hash.FakePeer()
+	first := &inter.Event{
+		Index:   1,
+		Creator: creator,
+		Parents: hash.NewEvents(hash.ZeroEvent),
+	}
+	second := &inter.Event{
+		Index:       1,
+		Creator:     creator,
+		Parents:     hash.NewEvents(hash.ZeroEvent),
+		LamportTime: 1,
+	}
+
+	key := crypto.GenerateKey().Public()
+
+	consensus.EXPECT().
+		TryPushEvent(gomock.Any(), first.Hash()).
+		Times(1)
+	consensus.EXPECT().
+		StakeOf(creator).
+		Return(uint64(1)).
+		AnyTimes()
+	consensus.EXPECT().
+		GetValidatorKey(creator).
+		Return(key).
+		AnyTimes()
+	consensus.EXPECT().
+		PushForkProof(gomock.Any(), key).
+		Do(func(proof *inter.ForkProof, _ *common.PublicKey) {
+			assert.Equal(creator, proof.Creator())
+			assert.Equal(first.Hash(), proof.First.Hash())
+			assert.Equal(second.Hash(), proof.Second.Hash())
+		})
+
+	node.initParents()
+	node.saveNewEvent(first)
+	node.saveNewEvent(second)
+
+	// the first event keeps index
+	assert.Equal(first.Hash(), *store.GetEventHash(creator, 1))
+	assert.True(store.HasEvent(second.Hash()))
+	// the second one is not propagated
+	assert.NotNil(node.parents.cache[first.Hash()])
+	assert.Nil(node.parents.cache[second.Hash()])
+}
+
+func TestConsensusQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		full   = true
+		pushed hash.EventsSlice
+		mu     sync.Mutex
+	)
+	consensus := NewMockConsensus(ctrl)
+	consensus.EXPECT().
+		TryPushEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, e hash.Event) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if full {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			pushed = append(pushed, e)
+			return nil
+		}).
+		AnyTimes()
+	consensus.EXPECT().
+		Backpressure().
+		Return(0.0).
+		AnyTimes()
+
+	node := NewForTests("fake", NewMemStore(), consensus)
+	node.conf.ClientTimeout = 10 * time.Millisecond
+
+	events := hash.FakeEvents(3).Slice()
+	for _, e := range events {
+		node.pushToConsensus(e)
+	}
+	assert.Equal(3, node.consensusBacklog(), "consensus queue is full")
+	assert.Equal(1.0, node.consensusPressure())
+
+	mu.Lock()
+	full = false
+	mu.Unlock()
+
+	for i := 0; i < 500 && node.consensusBacklog() > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(0, node.consensusBacklog())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(events, pushed, "events order is kept")
+}
+
+func TestForkProofGossip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := crypto.GenerateKey()
+	creator := hash.PeerOfPubkey(key.Public())
+	forged := hash.FakePeer()
+
+	signed := func(e *inter.Event) *inter.Event {
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+	proof := inter.NewForkProof(
+		signed(&inter.Event{Index: 1, Creator: creator}),
+		signed(&inter.Event{Index: 1, Creator: creator, LamportTime: 1}),
+	)
+	// signed by key of other creator
+	invalid := inter.NewForkProof(
+		signed(&inter.Event{Index: 1, Creator: forged}),
+		signed(&inter.Event{Index: 1, Creator: forged, LamportTime: 1}),
+	)
+
+	fakeConsensus := func() *MockConsensus {
+		c := NewMockConsensus(ctrl)
+		c.EXPECT().Backpressure().Return(0.0).AnyTimes()
+		c.EXPECT().GetGenesisHash().Return(hash.Hash{}).AnyTimes()
+		c.EXPECT().TryPushEvent(gomock.Any(), gomock.Any()).AnyTimes()
+		return c
+	}
+
+	// node 1 knows cheaters
+	consensus1 := fakeConsensus()
+	consensus1.EXPECT().
+		GetCheaters().
+		Return([]hash.Peer{creator, forged}).
+		AnyTimes()
+	consensus1.EXPECT().
+		GetForkProof(creator).
+		Return(proof).
+		AnyTimes()
+	consensus1.EXPECT().
+		GetForkProof(forged).
+		Return(invalid).
+		AnyTimes()
+	node1 := NewForTests("node1", NewMemStore(), consensus1)
+	node1.StartService()
+	defer node1.StopService()
+
+	// node 2 does not
+	consensus2 := fakeConsensus()
+	consensus2.EXPECT().
+		GetCheaters().
+		Return(nil).
+		AnyTimes()
+	consensus2.EXPECT().
+		GetForkProof(gomock.Any()).
+		Return(nil).
+		AnyTimes()
+	consensus2.EXPECT().
+		GetValidatorKey(creator).
+		Return(key.Public()).
+		AnyTimes()
+	consensus2.EXPECT().
+		GetValidatorKey(forged).
+		Return(crypto.GenerateKey().Public()).
+		AnyTimes()
+	consensus2.EXPECT().
+		PushForkProof(gomock.Any(), key.Public()).
+		Do(func(got *inter.ForkProof, _ *common.PublicKey) {
+			assert.Equal(t, proof.First.Hash(), got.First.Hash())
+			assert.Equal(t, proof.Second.Hash(), got.Second.Hash())
+		}).
+		Times(1)
+	node2 := NewForTests("node2", NewMemStore(), consensus2)
+
+	node2.store.BootstrapPeers(node1.AsPeer())
+	node2.initPeers()
+	node2.syncWithPeer(node1.AsPeer())
+
+	assert.True(t, node2.PeerReputation(creator).BannedForever, "cheater is banned")
+	assert.False(t, node2.PeerReputation(forged).BannedForever, "proof is not trusted")
+}
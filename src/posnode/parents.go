@@ -1,6 +1,8 @@
 package posnode
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
@@ -8,6 +10,21 @@ import (
 )
 
 type (
+	// ParentStrategy chooses parents of new event.
+	ParentStrategy interface {
+		// Choose returns index of the best candidate to be the next parent of new event
+		// or -1 if there is no suitable one. Chosen are already selected parents (self-parent first).
+		// Consensus may be nil.
+		Choose(c Consensus, chosen hash.Events, candidates []Candidate) int
+	}
+
+	// Candidate is a potential parent of new event.
+	Candidate struct {
+		Event   hash.Event
+		Creator hash.Peer
+		Value   uint64 // stake sum of the event and its not referenced yet ancestors
+	}
+
 	parent struct {
 		Creator hash.Peer
 		Parents hash.Events
@@ -38,11 +55,14 @@ func (n *Node) initParents() {
 	for _, peer := range n.peers.Snapshot() {
 		to := n.store.GetPeerHeight(peer)
 		from := uint64(1)
-		if (from + loadDeep) <= to {
-			from -= loadDeep
+		if to > loadDeep {
+			from = to - loadDeep + 1
 		}
 		for i := from; i <= to; i++ {
 			e := n.EventOf(peer, i)
+			if e == nil {
+				continue
+			}
 			val := uint64(1)
 			if n.consensus != nil {
 				val = n.consensus.StakeOf(e.Creator)
@@ -90,34 +110,42 @@ func (n *Node) pushPotentialParent(e *inter.Event) {
 	}
 }
 
-// popBestParent returns best parent and marks it as used.
-func (n *Node) popBestParent() *hash.Event {
+// popBestParent returns best parent by Config.Parents strategy and marks it as used.
+func (n *Node) popBestParent(chosen hash.Events) *hash.Event {
 	n.parents.Lock()
 	defer n.parents.Unlock()
 
-	var (
-		res *hash.Event
-		max uint64
-		tmp hash.Event
-	)
-
+	candidates := make([]Candidate, 0, len(n.parents.cache))
 	for e, p := range n.parents.cache {
-		if !p.Last {
+		if !p.Last || chosen.Contains(e) {
 			continue
 		}
-
-		val := n.parents.Sum(e)
-		if val > max {
-			tmp, res = e, &tmp
-			max = val
-		}
+		candidates = append(candidates, Candidate{
+			Event:   e,
+			Creator: p.Creator,
+			Value:   n.parents.Sum(e),
+		})
 	}
-
-	if res != nil {
-		n.parents.Del(*res)
+	if len(candidates) < 1 {
+		return nil
+	}
+	// deterministic order for strategies
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i].Event.Bytes(), candidates[j].Event.Bytes()) < 0
+	})
+
+	strategy := n.conf.Parents
+	if strategy == nil {
+		strategy = StakeParents()
+	}
+	i := strategy.Choose(n.consensus, chosen, candidates)
+	if i < 0 || i >= len(candidates) {
+		return nil
 	}
 
-	return res
+	res := candidates[i].Event
+	n.parents.Del(res)
+	return &res
 }
 
 /*
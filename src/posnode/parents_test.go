@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 )
@@ -52,7 +53,7 @@ func testParentSelection(t *testing.T, dsc, schema string) {
 
 		expected := ASCIIschemeToDAG(node, consensus, schema)
 		for n, expect := range expected {
-			parent := node.popBestParent()
+			parent := node.popBestParent(nil)
 			if !assert.NotNil(parent, "step %d", n) {
 				break
 			}
@@ -61,7 +62,7 @@ func testParentSelection(t *testing.T, dsc, schema string) {
 			}
 		}
 
-		assert.Nil(node.popBestParent(), "last step")
+		assert.Nil(node.popBestParent(nil), "last step")
 
 	})
 }
@@ -79,7 +80,7 @@ func ASCIIschemeToDAG(n *Node, c *MockConsensus, schema string) (expected []stri
 	}
 
 	c.EXPECT().
-		PushEvent(gomock.Any()).
+		TryPushEvent(gomock.Any(), gomock.Any()).
 		AnyTimes()
 	c.EXPECT().
 		StakeOf(gomock.Any()).
@@ -133,3 +134,115 @@ func (ss byOrderNum) Less(i, j int) bool {
 
 	return a < b
 }
+
+func TestParentsLoading(t *testing.T) {
+	assert := assert.New(t)
+
+	store := NewMemStore()
+	node := NewForTests("node0", store, nil)
+
+	key := crypto.GenerateKey()
+	peer := &Peer{
+		ID:     hash.PeerOfPubkey(key.Public()),
+		PubKey: key.Public(),
+		Host:   "peer.fake",
+	}
+	store.BootstrapPeers(peer)
+	node.initPeers()
+
+	const count = 15
+	for i := uint64(1); i <= count; i++ {
+		e := &inter.Event{
+			Index:   i,
+			Creator: peer.ID,
+		}
+		store.SetEvent(e)
+		store.SetEventHash(e.Creator, e.Index, e.Hash())
+	}
+	store.SetPeerHeight(peer.ID, count)
+
+	node.initParents()
+
+	assert.Len(node.parents.cache, 10, "loads the last events only")
+	last := store.GetEventHash(peer.ID, count)
+	assert.True(node.parents.cache[*last].Last)
+}
+
+func TestParentStrategies(t *testing.T) {
+	peers := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	candidates := []Candidate{
+		{Event: hash.FakeEvent(), Creator: peers[0], Value: 1},
+		{Event: hash.FakeEvent(), Creator: peers[1], Value: 3},
+		{Event: hash.FakeEvent(), Creator: peers[2], Value: 2},
+	}
+
+	t.Run("stake", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := StakeParents()
+		assert.Equal(1, s.Choose(nil, nil, candidates))
+		assert.Equal(-1, s.Choose(nil, nil, nil))
+	})
+
+	t.Run("random", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := RandomParents(0)
+		for i := 0; i < 10; i++ {
+			got := s.Choose(nil, nil, candidates)
+			assert.True(got >= 0 && got < len(candidates))
+		}
+		assert.Equal(-1, s.Choose(nil, nil, nil))
+	})
+
+	t.Run("diverse", func(t *testing.T) {
+		assert := assert.New(t)
+
+		s := DiverseParents()
+		assert.Equal(1, s.Choose(nil, nil, candidates))
+		assert.Equal(2, s.Choose(nil, nil, candidates))
+		assert.Equal(0, s.Choose(nil, nil, candidates))
+		assert.Equal(1, s.Choose(nil, nil, candidates), "next turn")
+	})
+
+	t.Run("root progress", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		self := hash.FakeEvent()
+		roots := map[hash.Event][]hash.Peer{
+			self:                {peers[0]},
+			candidates[0].Event: {peers[0]},
+			candidates[1].Event: {peers[0]},
+			candidates[2].Event: {peers[2]},
+		}
+		frames := map[hash.Event]uint64{
+			self:                2,
+			candidates[0].Event: 2,
+			candidates[1].Event: 1,
+			candidates[2].Event: 2,
+		}
+
+		consensus := NewMockConsensus(ctrl)
+		consensus.EXPECT().
+			KnownRoots(gomock.Any()).
+			DoAndReturn(func(e hash.Event) (uint64, []hash.Peer) {
+				return frames[e], roots[e]
+			}).
+			AnyTimes()
+		consensus.EXPECT().
+			StakeOf(gomock.Any()).
+			Return(uint64(1)).
+			AnyTimes()
+
+		s := RootParents()
+		assert.Equal(2, s.Choose(consensus, hash.NewEvents(self), candidates), "new root of frame")
+
+		frames[candidates[1].Event] = 3
+		assert.Equal(1, s.Choose(consensus, hash.NewEvents(self), candidates), "higher frame")
+
+		assert.Equal(1, s.Choose(nil, nil, candidates), "no consensus")
+	})
+}
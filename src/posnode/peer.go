@@ -1,10 +1,16 @@
 package posnode
 
 import (
+	"net"
+	"strconv"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/logger"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
@@ -14,6 +20,22 @@ type (
 		ID     hash.Peer
 		PubKey *common.PublicKey
 		Host   string
+		Addrs  *PeerAddrs
+	}
+
+	// PeerAddr is a network endpoint of peer.
+	PeerAddr struct {
+		Host       string
+		Port       int
+		Time       time.Time // when endpoint was set by peer
+		Advertised bool      // external address (e.g. NAT port mapping)
+	}
+
+	// PeerAddrs is a list of peer endpoints signed by the peer.
+	PeerAddrs struct {
+		List []PeerAddr
+		Time time.Time // record version, the newer replaces the older
+		Sign string
 	}
 
 	// hostAttr contains temporary attributes of host.
@@ -37,6 +59,7 @@ func (p *Peer) ToWire() *api.PeerInfo {
 		ID:     p.ID.Hex(),
 		PubKey: p.PubKey.Bytes(),
 		Host:   p.Host,
+		Addrs:  p.Addrs.ToWire(),
 	}
 }
 
@@ -49,7 +72,116 @@ func WireToPeer(w *api.PeerInfo) *Peer {
 		ID:     hash.HexToPeer(w.ID),
 		PubKey: common.BytesToPubkey(w.PubKey),
 		Host:   w.Host,
+		Addrs:  WireToPeerAddrs(w.Addrs),
+	}
+}
+
+// NetAddr returns the most suitable network address of peer.
+// Advertised endpoints are preferred, default port is used if peer has no signed addresses.
+func (p *Peer) NetAddr(defaultPort int) string {
+	if p.Addrs != nil {
+		var best *PeerAddr
+		for i, a := range p.Addrs.List {
+			switch {
+			case a.Host == "":
+				continue
+			case best == nil,
+				a.Advertised && !best.Advertised,
+				a.Advertised == best.Advertised && a.Host == p.Host && best.Host != p.Host:
+				best = &p.Addrs.List[i]
+			}
+		}
+		if best != nil {
+			return net.JoinHostPort(best.Host, strconv.Itoa(best.Port))
+		}
+	}
+
+	return net.JoinHostPort(p.Host, strconv.Itoa(defaultPort))
+}
+
+// SignBy signs addresses record by private key.
+func (a *PeerAddrs) SignBy(priv *common.PrivateKey) error {
+	R, S, err := priv.Sign(a.hash().Bytes())
+	if err != nil {
+		return err
+	}
+
+	a.Sign = crypto.EncodeSignature(R, S)
+	return nil
+}
+
+// Verify checks addresses record sign by public key.
+func (a *PeerAddrs) Verify(pub *common.PublicKey) bool {
+	if a.Sign == "" || pub == nil {
+		return false
 	}
+
+	r, s, err := crypto.DecodeSignature(a.Sign)
+	if err != nil {
+		return false
+	}
+
+	return pub.Verify(a.hash().Bytes(), r, s)
+}
+
+// ToWire converts to protobuf message.
+func (a *PeerAddrs) ToWire() *api.PeerAddrs {
+	if a == nil {
+		return nil
+	}
+
+	w := &api.PeerAddrs{
+		List: make([]*api.PeerAddr, len(a.List)),
+		Time: a.Time.UnixNano(),
+		Sign: a.Sign,
+	}
+	for i, addr := range a.List {
+		w.List[i] = &api.PeerAddr{
+			Host:       addr.Host,
+			Port:       uint32(addr.Port),
+			Time:       addr.Time.UnixNano(),
+			Advertised: addr.Advertised,
+		}
+	}
+
+	return w
+}
+
+// WireToPeerAddrs converts from protobuf message.
+func WireToPeerAddrs(w *api.PeerAddrs) *PeerAddrs {
+	if w == nil {
+		return nil
+	}
+
+	a := &PeerAddrs{
+		List: make([]PeerAddr, len(w.List)),
+		Time: time.Unix(0, w.Time),
+		Sign: w.Sign,
+	}
+	for i, addr := range w.List {
+		a.List[i] = PeerAddr{
+			Host:       addr.Host,
+			Port:       int(addr.Port),
+			Time:       time.Unix(0, addr.Time),
+			Advertised: addr.Advertised,
+		}
+	}
+
+	return a
+}
+
+// hash calcs hash of unsigned record.
+func (a *PeerAddrs) hash() hash.Hash {
+	w := a.ToWire()
+	w.Sign = ""
+
+	var pbf proto.Buffer
+	pbf.SetDeterministic(true)
+	if err := pbf.Marshal(w); err != nil {
+		logger.Get().Fatal(err)
+	}
+
+	return hash.Of(pbf.Bytes())
 }
 
 // IDsToWire converts to protobuf message.
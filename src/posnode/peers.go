@@ -22,6 +22,10 @@ type peers struct {
 func (n *Node) initPeers() {
 	n.initDownloads()
 	n.initClient()
+	n.initValidation()
+	n.initVerification()
+	n.initReputations()
+	n.initRouting()
 
 	if n.peers.top != nil {
 		return
@@ -110,6 +114,7 @@ func (n *Node) ConnectOK(p *Peer) {
 	if stored == nil {
 		return
 	}
+	n.touchPeer(p.ID)
 	if stored.Host != p.Host {
 		stored.Host = p.Host
 		n.store.SetPeer(stored)
@@ -130,6 +135,8 @@ func (n *Node) ConnectOK(p *Peer) {
 func (n *Node) ConnectFail(p *Peer, err error) {
 	n.Warn(err)
 
+	n.failedRequest(p, err)
+
 	n.peers.Lock()
 	defer n.peers.Unlock()
 
@@ -140,8 +147,24 @@ func (n *Node) ConnectFail(p *Peer, err error) {
 	n.peers.unordered = true
 }
 
+// InvalidEvent counts invalid event got from peer.
+func (n *Node) InvalidEvent(p *Peer, err error) {
+	n.Warnf("invalid event from %s: %s", p.ID.String(), err)
+
+	n.invalidData(p)
+
+	n.peers.Lock()
+	defer n.peers.Unlock()
+
+	n.peers.unordered = true
+}
+
 // PeerReadyForReq returns false if peer is not ready for request.
 func (n *Node) PeerReadyForReq(host string) bool {
+	if n.PeerBanned(hash.EmptyPeer, host) {
+		return false
+	}
+
 	n.peers.RLock()
 	defer n.peers.RUnlock()
 
@@ -182,7 +205,7 @@ func (n *Node) NextForGossip() *Peer {
 
 	// order and trunc the top
 	if n.peers.unordered {
-		sort.Sort((*gossipEvaluation)(n))
+		sort.Sort(n.newGossipEvaluation())
 		n.peers.unordered = false
 		if len(n.peers.top) > n.conf.TopPeersCount {
 			tail := n.peers.top[n.conf.TopPeersCount:]
@@ -194,14 +217,18 @@ func (n *Node) NextForGossip() *Peer {
 		}
 	}
 
-	// return first no busy
+	// return first no busy and no banned
 	for _, candidate := range n.peers.top {
 		attrs := n.peers.attrByID(candidate)
-		if !attrs.Busy {
-			attrs.Busy = true
-			peer := n.store.GetPeer(candidate)
-			return peer
+		if attrs.Busy {
+			continue
+		}
+		peer := n.store.GetPeer(candidate)
+		if peer == nil || n.PeerBanned(peer.ID, peer.Host) {
+			continue
 		}
+		attrs.Busy = true
+		return peer
 	}
 
 	return nil
@@ -0,0 +1,295 @@
+package posnode
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// reputation score weights.
+const (
+	usefulEventScore = 1
+	invalidDataScore = -100
+	timeoutScore     = -10
+	latencyScoreUnit = 100 * time.Millisecond // each unit of average latency costs 1
+)
+
+// reputationCacheSize is a max count of cached reputations,
+// evicted ones are loaded from store again.
+const reputationCacheSize = 10000
+
+type (
+	// Reputation is a behaviour record of peer or host.
+	Reputation struct {
+		UsefulEvents  uint64
+		InvalidData   uint64
+		Timeouts      uint64
+		Latency       time.Duration // moving average
+		BannedUntil   time.Time
+		BannedForever bool
+	}
+
+	// reputations is a cache of stored reputations.
+	reputations struct {
+		ids   *lru.Cache
+		hosts *lru.Cache
+
+		sync.Mutex
+	}
+)
+
+// Score returns reputation score, the higher the better.
+func (r *Reputation) Score() int64 {
+	return usefulEventScore*int64(r.UsefulEvents) +
+		invalidDataScore*int64(r.InvalidData) +
+		timeoutScore*int64(r.Timeouts) -
+		int64(r.Latency/latencyScoreUnit)
+}
+
+// IsBanned returns true if ban is active now.
+func (r *Reputation) IsBanned() bool {
+	return r.BannedForever || r.BannedUntil.After(time.Now())
+}
+
+// ToWire converts to protobuf message.
+func (r *Reputation) ToWire() *api.Reputation {
+	w := &api.Reputation{
+		UsefulEvents:  r.UsefulEvents,
+		InvalidData:   r.InvalidData,
+		Timeouts:      r.Timeouts,
+		Latency:       int64(r.Latency),
+		BannedForever: r.BannedForever,
+	}
+	if !r.BannedUntil.IsZero() {
+		w.BannedUntil = r.BannedUntil.UnixNano()
+	}
+	return w
+}
+
+// WireToReputation converts from protobuf message.
+func WireToReputation(w *api.Reputation) *Reputation {
+	if w == nil {
+		return nil
+	}
+	r := &Reputation{
+		UsefulEvents:  w.UsefulEvents,
+		InvalidData:   w.InvalidData,
+		Timeouts:      w.Timeouts,
+		Latency:       time.Duration(w.Latency),
+		BannedForever: w.BannedForever,
+	}
+	if w.BannedUntil != 0 {
+		r.BannedUntil = time.Unix(0, w.BannedUntil)
+	}
+	return r
+}
+
+func (n *Node) initReputations() {
+	n.reputations.Lock()
+	defer n.reputations.Unlock()
+
+	if n.reputations.ids != nil {
+		return
+	}
+
+	var err error
+	n.reputations.ids, err = lru.New(reputationCacheSize)
+	if err != nil {
+		n.Fatal(err)
+	}
+	n.reputations.hosts, err = lru.New(reputationCacheSize)
+	if err != nil {
+		n.Fatal(err)
+	}
+}
+
+// PeerReputation returns copy of peer reputation.
+func (n *Node) PeerReputation(id hash.Peer) Reputation {
+	n.initReputations()
+
+	n.reputations.Lock()
+	defer n.reputations.Unlock()
+
+	return *n.reputationOf(id)
+}
+
+// PeerBanned returns true if peer or its host is banned.
+func (n *Node) PeerBanned(id hash.Peer, host string) bool {
+	n.initReputations()
+
+	n.reputations.Lock()
+	defer n.reputations.Unlock()
+
+	if !id.IsEmpty() && n.reputationOf(id).IsBanned() {
+		return true
+	}
+	if host != "" && n.reputationOfHost(host).IsBanned() {
+		return true
+	}
+	return false
+}
+
+// BanPeer bans peer forever.
+func (n *Node) BanPeer(id hash.Peer, reason string) {
+	n.Warnf("peer %s is banned forever: %s", id.String(), reason)
+
+	n.updateReputation(&Peer{ID: id}, func(r *Reputation) {
+		r.BannedForever = true
+	})
+}
+
+// usefulEvents counts events got from peer.
+func (n *Node) usefulEvents(p *Peer, count int) {
+	n.updateReputation(p, func(r *Reputation) {
+		r.UsefulEvents += uint64(count)
+	})
+}
+
+// invalidData counts invalid data got from peer.
+func (n *Node) invalidData(p *Peer) {
+	n.updateReputation(p, n.countInvalid)
+}
+
+// countInvalid counts invalid data and bans temporary on each Config.BanInvalidData of them,
+// so high score of useful events does not cover invalid ones.
+func (n *Node) countInvalid(r *Reputation) {
+	r.InvalidData++
+
+	limit := n.conf.BanInvalidData
+	if limit > 0 && r.InvalidData%limit == 0 && !r.IsBanned() {
+		r.BannedUntil = time.Now().Add(n.conf.BanTimeout)
+	}
+}
+
+// peerLatency counts peer response time.
+func (n *Node) peerLatency(p *Peer, d time.Duration) {
+	n.updateReputation(p, func(r *Reputation) {
+		if r.Latency == 0 {
+			r.Latency = d
+		} else {
+			r.Latency = (r.Latency*7 + d) / 8
+		}
+	})
+}
+
+// failedRequest counts request error by kind.
+// Timeouts and authentication errors are host-level faults,
+// so they are counted for peer's host too.
+func (n *Node) failedRequest(p *Peer, err error) {
+	if err == nil {
+		return
+	}
+
+	timeout := func(r *Reputation) {
+		r.Timeouts++
+	}
+	invalid := n.countInvalid
+
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		n.updateReputation(p, timeout)
+		n.updateHostReputation(p, timeout)
+	case codes.Unauthenticated:
+		n.updateReputation(p, invalid)
+		n.updateHostReputation(p, invalid)
+	default:
+		if err == context.DeadlineExceeded {
+			n.updateReputation(p, timeout)
+			n.updateHostReputation(p, timeout)
+		}
+	}
+}
+
+// updateReputation applies change to peer reputation,
+// bans it if score is low and saves.
+func (n *Node) updateReputation(p *Peer, change func(*Reputation)) {
+	if p == nil || p.ID.IsEmpty() {
+		return
+	}
+	n.initReputations()
+
+	n.reputations.Lock()
+	defer n.reputations.Unlock()
+
+	r := n.reputationOf(p.ID)
+	change(r)
+	n.checkBan(r)
+	if n.store != nil {
+		n.store.SetPeerReputation(p.ID, r)
+	}
+}
+
+// updateHostReputation applies change to reputation of peer's host,
+// bans it if score is low and saves.
+// It is for host-level faults only (connection or authentication),
+// so bad peer does not ban the others of the same host.
+func (n *Node) updateHostReputation(p *Peer, change func(*Reputation)) {
+	if p == nil || p.Host == "" {
+		return
+	}
+	n.initReputations()
+
+	n.reputations.Lock()
+	defer n.reputations.Unlock()
+
+	r := n.reputationOfHost(p.Host)
+	change(r)
+	n.checkBan(r)
+	if n.store != nil {
+		n.store.SetHostReputation(p.Host, r)
+	}
+}
+
+// checkBan bans by score.
+func (n *Node) checkBan(r *Reputation) {
+	if r.BannedForever {
+		return
+	}
+	score := r.Score()
+	if score <= n.conf.BanForeverScore {
+		r.BannedForever = true
+		return
+	}
+	if score <= n.conf.BanScore && !r.IsBanned() {
+		r.BannedUntil = time.Now().Add(n.conf.BanTimeout)
+	}
+}
+
+func (n *Node) reputationOf(id hash.Peer) *Reputation {
+	if r, ok := n.reputations.ids.Get(id); ok {
+		return r.(*Reputation)
+	}
+
+	var r *Reputation
+	if n.store != nil {
+		r = n.store.GetPeerReputation(id)
+	}
+	if r == nil {
+		r = &Reputation{}
+	}
+	n.reputations.ids.Add(id, r)
+	return r
+}
+
+func (n *Node) reputationOfHost(host string) *Reputation {
+	if r, ok := n.reputations.hosts.Get(host); ok {
+		return r.(*Reputation)
+	}
+
+	var r *Reputation
+	if n.store != nil {
+		r = n.store.GetHostReputation(host)
+	}
+	if r == nil {
+		r = &Reputation{}
+	}
+	n.reputations.hosts.Add(host, r)
+	return r
+}
@@ -0,0 +1,143 @@
+package posnode
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
+)
+
+func TestReputation(t *testing.T) {
+	db := kvdb.NewMemDatabase()
+	store := NewStore(db)
+	node := NewForTests("node01", store, nil)
+	node.initPeers()
+
+	newPeer := func(host string) *Peer {
+		key := crypto.GenerateKey()
+		peer := &Peer{
+			ID:     hash.PeerOfPubkey(key.Public()),
+			PubKey: key.Public(),
+			Host:   host,
+		}
+		store.SetPeer(peer)
+		return peer
+	}
+
+	t.Run("score", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := newPeer("score")
+		node.usefulEvents(peer, 15)
+		node.peerLatency(peer, 500*time.Millisecond)
+		node.ConnectFail(peer, status.Error(codes.DeadlineExceeded, "timeout"))
+		node.ConnectFail(peer, fmt.Errorf("other error"))
+		node.ConnectFail(peer, nil)
+
+		r := node.PeerReputation(peer.ID)
+		assert.Equal(uint64(15), r.UsefulEvents)
+		assert.Equal(uint64(1), r.Timeouts)
+		assert.Equal(uint64(0), r.InvalidData)
+		assert.Equal(int64(15-10-5), r.Score())
+		assert.False(r.IsBanned())
+	})
+
+	t.Run("temporary ban", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := newPeer("temporary")
+		for i := 0; i < 3; i++ {
+			assert.False(node.PeerBanned(peer.ID, ""))
+			node.InvalidEvent(peer, fmt.Errorf("invalid"))
+		}
+
+		r := node.PeerReputation(peer.ID)
+		assert.True(r.IsBanned())
+		assert.False(r.BannedForever)
+		assert.False(node.PeerBanned(hash.EmptyPeer, peer.Host), "host is not banned for peer's data")
+
+		neighbor := newPeer(peer.Host)
+		assert.False(node.PeerBanned(neighbor.ID, neighbor.Host))
+	})
+
+	t.Run("invalid data ban", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := newPeer("useful")
+		node.usefulEvents(peer, 10000)
+		for i := uint64(0); i < node.conf.BanInvalidData; i++ {
+			assert.False(node.PeerBanned(peer.ID, ""))
+			node.InvalidEvent(peer, fmt.Errorf("invalid"))
+		}
+
+		r := node.PeerReputation(peer.ID)
+		assert.True(r.Score() > node.conf.BanScore)
+		assert.True(r.IsBanned(), "banned regardless of score")
+		assert.False(r.BannedForever)
+	})
+
+	t.Run("forever ban", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := newPeer("forever")
+		for i := 0; i < 10; i++ {
+			node.ConnectFail(peer, status.Error(codes.Unauthenticated, "genesis mismatch"))
+		}
+		assert.True(node.PeerReputation(peer.ID).BannedForever)
+		assert.True(node.PeerBanned(hash.EmptyPeer, peer.Host), "host is banned for auth faults")
+		assert.False(node.PeerReadyForReq(peer.Host))
+
+		forker := hash.FakePeer()
+		node.BanPeer(forker, "fork")
+		assert.True(node.PeerBanned(forker, ""))
+	})
+
+	t.Run("persistence", func(t *testing.T) {
+		assert := assert.New(t)
+
+		peer := newPeer("persistent")
+		node.BanPeer(peer.ID, "test")
+
+		other := NewForTests("node02", NewStore(db), nil)
+		assert.True(other.PeerBanned(peer.ID, ""))
+		assert.Equal(node.PeerReputation(peer.ID), other.PeerReputation(peer.ID))
+	})
+
+	t.Run("cache size", func(t *testing.T) {
+		assert := assert.New(t)
+
+		banned := newPeer("banned")
+		node.BanPeer(banned.ID, "test")
+
+		for i := 0; i < reputationCacheSize; i++ {
+			node.PeerBanned(hash.FakePeer(), "")
+		}
+		assert.Equal(reputationCacheSize, node.reputations.ids.Len())
+		assert.True(node.PeerBanned(banned.ID, ""), "evicted reputation is restored from store")
+	})
+
+	t.Run("gossip skips banned", func(t *testing.T) {
+		assert := assert.New(t)
+
+		good, bad := newPeer("good"), newPeer("bad")
+		node.ConnectOK(bad)
+		node.ConnectOK(good)
+		node.BanPeer(bad.ID, "test")
+
+		for i := 0; i < 3; i++ {
+			peer := node.NextForGossip()
+			if !assert.NotNil(peer) {
+				return
+			}
+			assert.NotEqual(bad.ID, peer.ID)
+			node.FreePeer(peer)
+		}
+	})
+}
@@ -0,0 +1,369 @@
+package posnode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
+)
+
+// routingBuckets is a count of k-buckets (bits of hash.Peer).
+const routingBuckets = len(hash.Peer{}) * 8
+
+type (
+	// routing is a Kademlia-like table of peers by XOR distance to self ID.
+	// Bucket index is a length of common prefix bits, bucket tail is the most recently seen.
+	routing struct {
+		buckets [][]hash.Peer
+		checks  chan bucketCheck
+
+		sync.RWMutex
+	}
+
+	// bucketCheck is a task to replace the least recently seen peer of full bucket
+	// with candidate if the peer does not respond.
+	bucketCheck struct {
+		oldest    hash.Peer
+		candidate hash.Peer
+	}
+
+	// peersByDistance implements sort.Interface.
+	peersByDistance struct {
+		ids    []hash.Peer
+		target hash.Peer
+	}
+)
+
+func (n *Node) initRouting() {
+	n.routing.Lock()
+	defer n.routing.Unlock()
+
+	if n.routing.buckets != nil {
+		return
+	}
+
+	n.routing.checks = make(chan bucketCheck, 100) // magic buffer size.
+	n.routing.buckets = make([][]hash.Peer, routingBuckets)
+	for i := range n.routing.buckets {
+		n.routing.buckets[i] = n.store.GetBucket(i)
+	}
+}
+
+// RoutingSize returns count of peers in routing table.
+func (n *Node) RoutingSize() (count int) {
+	n.initRouting()
+
+	n.routing.RLock()
+	defer n.routing.RUnlock()
+
+	for _, bucket := range n.routing.buckets {
+		count += len(bucket)
+	}
+	return
+}
+
+// touchPeer marks peer as the most recently seen in its bucket.
+// If bucket is full, it queues the least recently seen peer checking.
+func (n *Node) touchPeer(id hash.Peer) {
+	if id.IsEmpty() || id == n.ID {
+		return
+	}
+	n.initRouting()
+
+	n.routing.Lock()
+	defer n.routing.Unlock()
+
+	i := commonPrefixLen(n.ID, id)
+	bucket := n.routing.buckets[i]
+
+	for j, exist := range bucket {
+		if exist == id {
+			copy(bucket[j:], bucket[j+1:])
+			bucket[len(bucket)-1] = id
+			n.store.SetBucket(i, bucket)
+			return
+		}
+	}
+
+	if len(bucket) < n.conf.BucketSize {
+		n.routing.buckets[i] = append(bucket, id)
+		n.store.SetBucket(i, n.routing.buckets[i])
+		return
+	}
+
+	select {
+	case n.routing.checks <- bucketCheck{
+		oldest:    bucket[0],
+		candidate: id,
+	}:
+	default:
+		n.Warn("routing.checks queue is full, so skipped")
+	}
+}
+
+// checkBucket pings the least recently seen peer and replaces it with candidate if no response.
+func (n *Node) checkBucket(task bucketCheck) {
+	if peer := n.store.GetPeer(task.oldest); peer != nil {
+		if _, err := n.pingPeer(peer); err == nil {
+			return
+		}
+	}
+
+	n.routing.Lock()
+	defer n.routing.Unlock()
+
+	i := commonPrefixLen(n.ID, task.oldest)
+	bucket := n.routing.buckets[i]
+	if len(bucket) < 1 || bucket[0] != task.oldest {
+		return
+	}
+
+	for _, exist := range bucket {
+		if exist == task.candidate {
+			return
+		}
+	}
+
+	bucket = append(bucket[1:], task.candidate)
+	n.routing.buckets[i] = bucket
+	n.store.SetBucket(i, bucket)
+}
+
+// closestPeers returns up to count peers of routing table closest to target.
+func (n *Node) closestPeers(target hash.Peer, count int) []hash.Peer {
+	n.initRouting()
+
+	n.routing.RLock()
+	ids := make([]hash.Peer, 0, count*2)
+	for _, bucket := range n.routing.buckets {
+		ids = append(ids, bucket...)
+	}
+	n.routing.RUnlock()
+
+	sort.Sort(peersByDistance{ids, target})
+	if len(ids) > count {
+		ids = ids[:count]
+	}
+	return ids
+}
+
+// discoverNetwork bootstraps routing table from builtin peers,
+// refreshes it by self lookup and spreads changed self addresses.
+func (n *Node) discoverNetwork() {
+	if n.RoutingSize() < 1 {
+		host := n.NextBuiltInPeer()
+		if host == "" {
+			return
+		}
+		if _, err := n.PingHost(host); err != nil {
+			// peer does not support routing
+			if status.Code(err) == codes.Unimplemented {
+				n.AskPeerInfo(host, nil)
+			}
+			return
+		}
+	}
+
+	n.LookupPeers(n.ID)
+	n.spreadAddrs()
+}
+
+// LookupPeers iteratively asks the closest known peers for peers closer to target.
+// Returns the closest found peers.
+func (n *Node) LookupPeers(target hash.Peer) []hash.Peer {
+	count := n.conf.BucketSize
+	shortlist := n.closestPeers(target, count)
+	queried := map[hash.Peer]bool{
+		n.ID: true,
+	}
+
+	for {
+		next := make([]hash.Peer, 0, n.conf.DiscoveryAlpha)
+		for _, id := range shortlist {
+			if !queried[id] {
+				queried[id] = true
+				next = append(next, id)
+			}
+			if len(next) >= n.conf.DiscoveryAlpha {
+				break
+			}
+		}
+		if len(next) < 1 {
+			break
+		}
+
+		results := make(chan []*Peer, len(next))
+		for _, id := range next {
+			go func(id hash.Peer) {
+				results <- n.findNode(id, target)
+			}(id)
+		}
+
+		known := make(map[hash.Peer]bool, len(shortlist))
+		for _, id := range shortlist {
+			known[id] = true
+		}
+		for range next {
+			for _, peer := range <-results {
+				if !known[peer.ID] && peer.ID != n.ID {
+					known[peer.ID] = true
+					shortlist = append(shortlist, peer.ID)
+				}
+			}
+		}
+
+		sort.Sort(peersByDistance{shortlist, target})
+		if len(shortlist) > count {
+			shortlist = shortlist[:count]
+		}
+	}
+
+	return shortlist
+}
+
+// findNode does FindNode request to peer and stores new peers from response.
+func (n *Node) findNode(id hash.Peer, target hash.Peer) []*Peer {
+	peer := n.store.GetPeer(id)
+	if peer == nil || n.PeerBanned(peer.ID, peer.Host) {
+		return nil
+	}
+
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return nil
+	}
+	defer free()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	source, ctx := api.ServerPeerID(ctx)
+
+	resp, err := client.FindNode(ctx, &api.NodeLookup{
+		Target: target.Hex(),
+		Count:  uint32(n.conf.BucketSize),
+	})
+	if err != nil {
+		fail(err)
+		n.ConnectFail(peer, err)
+		return nil
+	}
+
+	if *source != peer.ID {
+		n.ConnectFail(peer, fmt.Errorf("bad FindNode() response source"))
+		return nil
+	}
+	n.ConnectOK(peer)
+
+	res := make([]*Peer, 0, len(resp.Peers))
+	for _, info := range resp.Peers {
+		if info.Host == "" || checkPeerInfo(info) != nil {
+			n.invalidData(peer)
+			continue
+		}
+		found := WireToPeer(info)
+		if found.ID == n.ID {
+			continue
+		}
+		// host is trusted by direct connection only
+		if stored := n.store.GetWirePeer(found.ID); stored != nil {
+			info.Host = stored.Host
+		}
+		n.savePeerInfo(info)
+		res = append(res, found)
+	}
+
+	return res
+}
+
+// PingHost does Ping request to host and stores peer from response.
+func (n *Node) PingHost(host string) (*Peer, error) {
+	return n.pingPeer(&Peer{Host: host})
+}
+
+// pingPeer sends self info to peer and stores peer info from response.
+func (n *Node) pingPeer(peer *Peer) (*Peer, error) {
+	host := peer.Host
+	if !n.PeerReadyForReq(host) {
+		return nil, fmt.Errorf("host %s is not ready for request", host)
+	}
+
+	client, free, fail, err := n.ConnectTo(peer)
+	if err != nil {
+		n.ConnectFail(peer, err)
+		return nil, err
+	}
+	defer free()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+	defer cancel()
+
+	source, ctx := api.ServerPeerID(ctx)
+
+	info, err := client.Ping(ctx, n.AsPeer().ToWire())
+	if err != nil {
+		fail(err)
+		n.ConnectFail(peer, err)
+		return nil, err
+	}
+
+	if err = checkPeerInfo(info); err != nil || hash.HexToPeer(info.ID) != *source {
+		err = fmt.Errorf("bad Ping() response: %v", err)
+		n.ConnectFail(peer, err)
+		return nil, err
+	}
+
+	info.Host = host
+	peer = WireToPeer(info)
+	n.savePeerInfo(info)
+	n.ConnectOK(peer)
+
+	return peer, nil
+}
+
+/*
+ * Utils:
+ */
+
+// commonPrefixLen returns count of equal leading bits of different IDs.
+func commonPrefixLen(a, b hash.Peer) int {
+	for i := range a {
+		if x := a[i] ^ b[i]; x != 0 {
+			n := 0
+			for ; x&0x80 == 0; x <<= 1 {
+				n++
+			}
+			return i*8 + n
+		}
+	}
+	return routingBuckets - 1
+}
+
+// Len is the number of elements in the collection.
+func (pp peersByDistance) Len() int {
+	return len(pp.ids)
+}
+
+// Swap swaps the elements with indexes i and j.
+func (pp peersByDistance) Swap(i, j int) {
+	pp.ids[i], pp.ids[j] = pp.ids[j], pp.ids[i]
+}
+
+// Less reports whether the element with
+// index i is closer to target than the element with index j.
+func (pp peersByDistance) Less(i, j int) bool {
+	var a, b hash.Peer
+	for k := range pp.target {
+		a[k] = pp.ids[i][k] ^ pp.target[k]
+		b[k] = pp.ids[j][k] ^ pp.target[k]
+	}
+	return bytes.Compare(a[:], b[:]) < 0
+}
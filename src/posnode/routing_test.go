@@ -0,0 +1,105 @@
+package posnode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
+)
+
+func TestRoutingBuckets(t *testing.T) {
+	db := kvdb.NewMemDatabase()
+	store := NewStore(db)
+	node := NewForTests("node0", store, nil)
+	node.conf.BucketSize = 2
+	node.initPeers()
+
+	// peers of the farthest bucket
+	far := func() hash.Peer {
+		id := hash.FakePeer()
+		id[0] = ^node.ID[0]
+		return id
+	}
+
+	t.Run("prefix", func(t *testing.T) {
+		assert := assert.New(t)
+
+		a, b := hash.Peer{}, hash.Peer{}
+		b[1] = 0x20
+		assert.Equal(10, commonPrefixLen(a, b))
+		assert.Equal(0, commonPrefixLen(node.ID, far()))
+	})
+
+	t.Run("fill", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ids := []hash.Peer{far(), far(), far()}
+		for _, id := range ids {
+			node.touchPeer(id)
+		}
+		assert.Equal([]hash.Peer{ids[0], ids[1]}, node.routing.buckets[0])
+		if !assert.Len(node.routing.checks, 1) {
+			return
+		}
+
+		// unreachable oldest is replaced
+		node.checkBucket(<-node.routing.checks)
+		assert.Equal([]hash.Peer{ids[1], ids[2]}, node.routing.buckets[0])
+
+		node.touchPeer(ids[1])
+		assert.Equal([]hash.Peer{ids[2], ids[1]}, node.routing.buckets[0], "the most recently seen is last")
+	})
+
+	t.Run("persistence", func(t *testing.T) {
+		assert := assert.New(t)
+
+		other := NewForTests("node0", NewStore(db), nil)
+		other.ID = node.ID
+		assert.ElementsMatch(node.routing.buckets[0], other.closestPeers(node.ID, 10))
+	})
+
+	t.Run("closest", func(t *testing.T) {
+		assert := assert.New(t)
+
+		target := node.routing.buckets[0][1]
+		assert.Equal(target, node.closestPeers(target, 1)[0])
+	})
+}
+
+func TestRoutingLookup(t *testing.T) {
+	assert := assert.New(t)
+
+	const count = 8
+
+	nodes := make([]*Node, count)
+	for i := range nodes {
+		nodes[i] = NewForTests(fmt.Sprintf("routing%d", i), NewMemStore(), nil)
+		nodes[i].initPeers()
+		nodes[i].StartService()
+		defer nodes[i].StopService()
+		nodes[i].AddBuiltInPeers(nodes[0].Host())
+	}
+
+	// bootstrap one by one
+	for _, n := range nodes[1:] {
+		n.discoverNetwork()
+	}
+
+	// everyone finds everyone
+	for _, n := range nodes {
+		for _, target := range nodes {
+			if target == n {
+				continue
+			}
+			found := n.LookupPeers(target.ID)
+			if !assert.NotEmpty(found) {
+				return
+			}
+			assert.Equal(target.ID, found[0], "%s looks for %s", n.Host(), target.Host())
+			assert.Equal(target.AsPeer(), n.store.GetPeer(target.ID))
+		}
+	}
+}
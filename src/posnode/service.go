@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,8 +16,9 @@ import (
 )
 
 type service struct {
-	listen network.ListenFunc
-	server *grpc.Server
+	listen  network.ListenFunc
+	server  *grpc.Server
+	limiter *api.Limiter
 }
 
 // StartService starts node service.
@@ -34,8 +36,25 @@ func (n *Node) StartService() {
 		genesis = n.consensus.GetGenesisHash()
 	}
 
+	if n.service.limiter == nil {
+		n.service.limiter = api.NewLimiter(api.Limits{
+			PeerRate:        n.conf.PeerRequestRate,
+			PeerBurst:       n.conf.PeerRequestBurst,
+			TotalRate:       n.conf.TotalRequestRate,
+			TotalBurst:      n.conf.TotalRequestBurst,
+			PeerStreams:     n.conf.PeerStreams,
+			TotalStreams:    n.conf.TotalStreams,
+			MaxResponseSize: n.conf.ResponseMaxSize,
+		})
+	}
+
 	bind := n.NetAddrOf(n.host)
-	n.server, _ = api.StartService(bind, n.key, genesis, n, n.Infof, n.service.listen)
+	if n.conf.TLS {
+		n.server, _ = api.StartTLSService(bind, n.key, genesis, n.service.limiter, n, n.Infof, n.service.listen)
+	} else {
+		guard := api.NewReplayGuard(n.conf.ReplayWindow, n.conf.ReplayCacheSize)
+		n.server, _ = api.StartService(bind, n.key, genesis, guard, n.service.limiter, n, n.Infof, n.service.listen)
+	}
 
 	n.Info("service started")
 }
@@ -51,13 +70,21 @@ func (n *Node) StopService() {
 	n.Info("service stopped")
 }
 
+// ThrottledRequests returns counters of rejected by limits requests of each peer.
+func (n *Node) ThrottledRequests() map[hash.Peer]uint64 {
+	if n.service.limiter == nil {
+		return map[hash.Peer]uint64{}
+	}
+	return n.service.limiter.Throttled()
+}
+
 /*
  * api.NodeServer implementation:
  */
 
 // SyncEvents returns their known event heights excluding heights from request.
 func (n *Node) SyncEvents(ctx context.Context, req *api.KnownEvents) (*api.KnownEvents, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -78,12 +105,15 @@ func (n *Node) SyncEvents(ctx context.Context, req *api.KnownEvents) (*api.Known
 	// TODO: should we remember other node's knowns for future request?
 	// to_download := PeersHeightsDiff(req.Lasts, known)
 	diff := PeersHeightsDiff(knownLasts, req.Lasts)
-	return &api.KnownEvents{Lasts: diff}, nil
+	return &api.KnownEvents{
+		Lasts:    diff,
+		Cheaters: n.knownCheaters(),
+	}, nil
 }
 
 // GetEvent returns requested event.
 func (n *Node) GetEvent(ctx context.Context, req *api.EventRequest) (*wire.Event, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -112,9 +142,83 @@ func (n *Node) GetEvent(ctx context.Context, req *api.EventRequest) (*wire.Event
 	return event, nil
 }
 
+// GetEvents streams requested range of creator's events.
+// Stream size is limited, so client should request the rest again.
+func (n *Node) GetEvents(req *api.EventsRequest, stream api.Node_GetEventsServer) error {
+	ctx := stream.Context()
+	if err := n.checkSource(ctx); err != nil {
+		return err
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	n.CheckPeerIsKnown(host, nil)
+
+	if req.From < 1 || req.From > req.To {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid range: %d-%d", req.From, req.To))
+	}
+
+	limit := n.conf.StreamMaxSize
+	if req.MaxSize > 0 && req.MaxSize < uint64(limit) {
+		limit = int(req.MaxSize)
+	}
+
+	creator := hash.HexToPeer(req.PeerID)
+	size := 0
+	for i := req.From; i <= req.To; i++ {
+		h := n.store.GetEventHash(creator, i)
+		if h == nil {
+			if i == req.From {
+				return status.Error(codes.NotFound, fmt.Sprintf("event not found: %s-%d", req.PeerID, i))
+			}
+			return nil
+		}
+
+		event := n.store.GetWireEvent(*h)
+		if event == nil {
+			return status.Error(codes.NotFound, fmt.Sprintf("event not found: %s", h.Hex()))
+		}
+
+		// NOTE: the first event is sent anyway
+		size += proto.Size(event)
+		if size > limit && i > req.From {
+			return nil
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AnnounceEvents takes hashes of new events and returns unknown of them.
+// Unknown events will be downloaded from the announcer.
+func (n *Node) AnnounceEvents(ctx context.Context, req *api.EventHashes) (*api.EventHashes, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	// food for discovery
+	host := api.GrpcPeerHost(ctx)
+	source := api.GrpcPeerID(ctx)
+	n.CheckPeerIsKnown(host, &source)
+
+	unknowns := n.onAnnounce(source, req)
+
+	resp := &api.EventHashes{
+		Hashes: make([][]byte, 0, len(unknowns)),
+	}
+	for e := range unknowns {
+		resp.Hashes = append(resp.Hashes, e.Bytes())
+	}
+	return resp, nil
+}
+
 // GetPeerInfo returns requested peer info.
 func (n *Node) GetPeerInfo(ctx context.Context, req *api.PeerRequest) (*api.PeerInfo, error) {
-	if err := checkSource(ctx); err != nil {
+	if err := n.checkSource(ctx); err != nil {
 		return nil, err
 	}
 
@@ -143,6 +247,88 @@ func (n *Node) GetPeerInfo(ctx context.Context, req *api.PeerRequest) (*api.Peer
 	return info, nil
 }
 
+// FindNode returns known peers closest to requested target.
+func (n *Node) FindNode(ctx context.Context, req *api.NodeLookup) (*api.PeerInfos, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	source := api.GrpcPeerID(ctx)
+	if n.store.GetWirePeer(source) != nil {
+		n.touchPeer(source)
+	}
+
+	count := int(req.Count)
+	if count < 1 || count > n.conf.BucketSize {
+		count = n.conf.BucketSize
+	}
+
+	target := hash.HexToPeer(req.Target)
+	resp := &api.PeerInfos{
+		Peers: make([]*api.PeerInfo, 0, count),
+	}
+	for _, id := range n.closestPeers(target, count+1) {
+		if id == source {
+			continue
+		}
+		info := n.store.GetWirePeer(id)
+		if info == nil {
+			continue
+		}
+		resp.Peers = append(resp.Peers, info)
+		if len(resp.Peers) >= count {
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+// Ping takes source peer info and returns self info.
+func (n *Node) Ping(ctx context.Context, req *api.PeerInfo) (*api.PeerInfo, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	source := api.GrpcPeerID(ctx)
+	if hash.HexToPeer(req.ID) != source {
+		return nil, status.Error(codes.InvalidArgument, "peer info mismatch")
+	}
+	if err := checkPeerInfo(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info := &api.PeerInfo{
+		ID:     req.ID,
+		PubKey: req.PubKey,
+		Host:   api.GrpcPeerHost(ctx),
+		Addrs:  req.Addrs,
+	}
+	n.savePeerInfo(info)
+	n.touchPeer(source)
+
+	self := n.AsPeer()
+	return self.ToWire(), nil
+}
+
+// GetForkProof returns cheating evidence of peer.
+func (n *Node) GetForkProof(ctx context.Context, req *api.PeerRequest) (*wire.ForkProof, error) {
+	if err := n.checkSource(ctx); err != nil {
+		return nil, err
+	}
+
+	if n.consensus == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fork proof not found: %s", req.PeerID))
+	}
+
+	proof := n.consensus.GetForkProof(hash.HexToPeer(req.PeerID))
+	if proof == nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("fork proof not found: %s", req.PeerID))
+	}
+
+	return proof.ToWire(), nil
+}
+
 /*
  * Utils:
  */
@@ -158,10 +344,13 @@ func PeersHeightsDiff(all, excepts map[string]uint64) (res map[string]uint64) {
 	return
 }
 
-func checkSource(ctx context.Context) error {
+func (n *Node) checkSource(ctx context.Context) error {
 	source := api.GrpcPeerID(ctx)
 	if source.IsEmpty() {
 		return status.Error(codes.Unauthenticated, "unknown peer")
 	}
+	if n.PeerBanned(source, api.GrpcPeerHost(ctx)) {
+		return status.Error(codes.PermissionDenied, "banned peer")
+	}
 	return nil
 }
@@ -4,10 +4,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/posnode/api"
 )
 
@@ -74,3 +81,167 @@ func TestGetPeerInfo(t *testing.T) {
 	})
 
 }
+
+func TestGetEvents(t *testing.T) {
+	store := NewMemStore()
+	n := NewForTests("server.fake", store, nil)
+	n.StartService()
+	defer n.StopService()
+
+	key := crypto.GenerateKey()
+	creator := &Peer{
+		ID:     hash.PeerOfPubkey(key.Public()),
+		PubKey: key.Public(),
+		Host:   "creator.fake",
+	}
+	events := make([]*inter.Event, 5)
+	for i := range events {
+		e := &inter.Event{
+			Index:       uint64(i + 1),
+			Creator:     creator.ID,
+			Parents:     hash.NewEvents(hash.ZeroEvent),
+			LamportTime: inter.Timestamp(i + 1),
+		}
+		if i > 0 {
+			e.Parents = hash.NewEvents(events[i-1].Hash())
+		}
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		store.SetEvent(e)
+		store.SetEventHash(e.Creator, e.Index, e.Hash())
+		store.SetPeerHeight(e.Creator, e.Index)
+		events[i] = e
+	}
+
+	cstore := NewMemStore()
+	cstore.SetPeer(creator)
+	c := NewForTests("client.fake", cstore, nil)
+	c.conf.StreamBatch = 2
+	c.initPeers()
+
+	client, free, _, err := c.ConnectTo(n.AsPeer())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer free()
+
+	t.Run("size cap", func(t *testing.T) {
+		assert := assert.New(t)
+
+		req := &api.EventsRequest{
+			PeerID:  creator.ID.Hex(),
+			From:    1,
+			To:      5,
+			MaxSize: uint64(proto.Size(events[0].ToWire()) + proto.Size(events[1].ToWire())),
+		}
+		var got []*inter.Event
+		count, err := c.streamEvents(client, n.AsPeer(), req, func(e *inter.Event) error {
+			got = append(got, e)
+			return nil
+		})
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(uint64(2), count)
+		assert.Equal(events[0].Hash(), got[0].Hash())
+		assert.Equal(events[1].Hash(), got[1].Hash())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		assert := assert.New(t)
+
+		req := &api.EventsRequest{
+			PeerID: creator.ID.Hex(),
+			From:   6,
+			To:     7,
+		}
+		_, err := c.streamEvents(client, n.AsPeer(), req, func(e *inter.Event) error {
+			return nil
+		})
+		assert.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("download", func(t *testing.T) {
+		assert := assert.New(t)
+
+		n.conf.StreamMaxSize = 1
+		defer func() {
+			n.conf.StreamMaxSize = DefaultConfig().StreamMaxSize
+		}()
+
+		parents, err := c.downloadEvents(client, n.AsPeer(), creator.ID, interval{1, 5})
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(uint64(5), cstore.GetPeerHeight(creator.ID))
+		assert.True(parents.Contains(events[3].Hash()))
+	})
+
+	t.Run("unary fallback", func(t *testing.T) {
+		assert := assert.New(t)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		stream := api.NewMockNode_GetEventsClient(ctrl)
+		stream.EXPECT().
+			Recv().
+			Return(nil, status.Error(codes.Unimplemented, "unknown method"))
+
+		client := api.NewMockNodeClient(ctrl)
+		client.EXPECT().
+			GetEvents(gomock.Any(), gomock.Any()).
+			Return(stream, nil)
+		client.EXPECT().
+			GetEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *api.EventRequest, _ ...grpc.CallOption) (*wire.Event, error) {
+				return events[req.Index-1].ToWire(), nil
+			}).
+			Times(5)
+
+		fstore := NewMemStore()
+		fstore.SetPeer(creator)
+		f := NewForTests("fallback.fake", fstore, nil)
+		f.initPeers()
+
+		// mock does not identify server, so peer id is unknown
+		peer := &Peer{Host: n.Host()}
+		_, err := f.downloadEvents(client, peer, creator.ID, interval{1, 5})
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(uint64(5), fstore.GetPeerHeight(creator.ID))
+	})
+}
+
+func TestServiceLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	n := NewForTests("server.fake", NewMemStore(), nil)
+	n.conf.PeerRequestRate = 0.001
+	n.conf.PeerRequestBurst = 2
+	n.StartService()
+	defer n.StopService()
+
+	c := NewForTests("client.fake", nil, nil)
+	c.initClient()
+
+	client, free, _, err := c.ConnectTo(n.AsPeer())
+	if !assert.NoError(err) {
+		return
+	}
+	defer free()
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), n.conf.ClientTimeout)
+		_, err = client.GetPeerInfo(ctx, &api.PeerRequest{})
+		cancel()
+		if i < 2 {
+			assert.NoError(err)
+		}
+	}
+	assert.Equal(codes.ResourceExhausted, status.Code(err))
+
+	assert.Equal(map[hash.Peer]uint64{c.ID: 1}, n.ThrottledRequests())
+}
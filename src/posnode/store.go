@@ -18,6 +18,10 @@ type Store struct {
 	peers       kvdb.Database
 	peersTop    kvdb.Database
 	peerHeights kvdb.Database
+	buckets     kvdb.Database
+
+	peerReputations kvdb.Database
+	hostReputations kvdb.Database
 
 	events kvdb.Database
 	hashes kvdb.Database
@@ -45,6 +49,10 @@ func (s *Store) init() {
 	s.peers = kvdb.NewTable(s.physicalDB, "peer_")
 	s.peersTop = kvdb.NewTable(s.physicalDB, "top_peers_")
 	s.peerHeights = kvdb.NewTable(s.physicalDB, "peer_height_")
+	s.buckets = kvdb.NewTable(s.physicalDB, "bucket_")
+
+	s.peerReputations = kvdb.NewTable(s.physicalDB, "reputation_peer_")
+	s.hostReputations = kvdb.NewTable(s.physicalDB, "reputation_host_")
 
 	s.events = kvdb.NewTable(s.physicalDB, "event_")
 	s.hashes = kvdb.NewTable(s.physicalDB, "hash_")
@@ -52,6 +60,9 @@ func (s *Store) init() {
 
 // Close leaves underlying database.
 func (s *Store) Close() {
+	s.hostReputations = nil
+	s.peerReputations = nil
+	s.buckets = nil
 	s.peerHeights = nil
 	s.peersTop = nil
 	s.peers = nil
@@ -201,6 +212,40 @@ func (s *Store) GetPeerHeight(id hash.Peer) uint64 {
 	return bytesToInt(buf)
 }
 
+// SetBucket stores routing bucket.
+func (s *Store) SetBucket(i int, ids []hash.Peer) {
+	w := IDsToWire(ids)
+	s.set(s.buckets, intToBytes(uint64(i)), w)
+}
+
+// GetBucket returns stored routing bucket.
+func (s *Store) GetBucket(i int) []hash.Peer {
+	w, _ := s.get(s.buckets, intToBytes(uint64(i)), &api.PeerIDs{}).(*api.PeerIDs)
+	return WireToIDs(w)
+}
+
+// SetPeerReputation stores reputation of peer.
+func (s *Store) SetPeerReputation(id hash.Peer, r *Reputation) {
+	s.set(s.peerReputations, id.Bytes(), r.ToWire())
+}
+
+// GetPeerReputation returns stored reputation of peer.
+func (s *Store) GetPeerReputation(id hash.Peer) *Reputation {
+	w, _ := s.get(s.peerReputations, id.Bytes(), &api.Reputation{}).(*api.Reputation)
+	return WireToReputation(w)
+}
+
+// SetHostReputation stores reputation of host.
+func (s *Store) SetHostReputation(host string, r *Reputation) {
+	s.set(s.hostReputations, []byte(host), r.ToWire())
+}
+
+// GetHostReputation returns stored reputation of host.
+func (s *Store) GetHostReputation(host string) *Reputation {
+	w, _ := s.get(s.hostReputations, []byte(host), &api.Reputation{}).(*api.Reputation)
+	return WireToReputation(w)
+}
+
 /*
  * Utils:
  */
@@ -0,0 +1,166 @@
+package posnode
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+type (
+	// stakeParents prefers candidates with max stake sum.
+	stakeParents struct{}
+
+	// randomParents chooses candidates randomly, it is for testing.
+	randomParents struct {
+		rand *rand.Rand
+		sync.Mutex
+	}
+
+	// diverseParents prefers creators which were chosen less often.
+	diverseParents struct {
+		counts map[hash.Peer]uint64
+		sync.Mutex
+	}
+
+	// rootParents prefers candidates which help new event to become a root.
+	rootParents struct{}
+)
+
+// StakeParents returns strategy which greedy chooses candidates by stake sum.
+// It is the default strategy.
+func StakeParents() ParentStrategy {
+	return stakeParents{}
+}
+
+// RandomParents returns strategy which chooses random candidates.
+func RandomParents(seed int64) ParentStrategy {
+	return &randomParents{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// DiverseParents returns strategy which chooses creators in turn.
+func DiverseParents() ParentStrategy {
+	return &diverseParents{
+		counts: make(map[hash.Peer]uint64),
+	}
+}
+
+// RootParents returns strategy which maximizes root progress:
+// the highest frame first, then the most stake of the frame roots known.
+// It falls back to StakeParents() without consensus.
+func RootParents() ParentStrategy {
+	return rootParents{}
+}
+
+// Choose implements ParentStrategy.
+func (stakeParents) Choose(_ Consensus, _ hash.Events, candidates []Candidate) int {
+	best := -1
+	var max uint64
+	for i, c := range candidates {
+		if c.Value > max {
+			best, max = i, c.Value
+		}
+	}
+	return best
+}
+
+// Choose implements ParentStrategy.
+func (s *randomParents) Choose(_ Consensus, _ hash.Events, candidates []Candidate) int {
+	if len(candidates) < 1 {
+		return -1
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	return s.rand.Intn(len(candidates))
+}
+
+// Choose implements ParentStrategy.
+func (s *diverseParents) Choose(_ Consensus, _ hash.Events, candidates []Candidate) int {
+	s.Lock()
+	defer s.Unlock()
+
+	best := -1
+	for i, c := range candidates {
+		if best < 0 {
+			best = i
+			continue
+		}
+		a, b := s.counts[c.Creator], s.counts[candidates[best].Creator]
+		if a < b || (a == b && c.Value > candidates[best].Value) {
+			best = i
+		}
+	}
+
+	if best >= 0 {
+		s.counts[candidates[best].Creator]++
+	}
+	return best
+}
+
+// Choose implements ParentStrategy.
+func (s rootParents) Choose(c Consensus, chosen hash.Events, candidates []Candidate) int {
+	if c == nil {
+		return stakeParents{}.Choose(c, chosen, candidates)
+	}
+
+	// roots known by chosen parents
+	frame, roots := uint64(0), map[hash.Peer]struct{}{}
+	for e := range chosen {
+		if e.IsZero() {
+			continue
+		}
+		frame, roots = mergeRoots(c, frame, roots, e)
+	}
+
+	var (
+		best      = -1
+		bestFrame uint64
+		bestStake uint64
+	)
+	for i, cand := range candidates {
+		f, rr := mergeRoots(c, frame, copyRoots(roots), cand.Event)
+		var stake uint64
+		for p := range rr {
+			stake += c.StakeOf(p)
+		}
+
+		if best < 0 || f > bestFrame ||
+			(f == bestFrame && stake > bestStake) ||
+			(f == bestFrame && stake == bestStake && cand.Value > candidates[best].Value) {
+			best, bestFrame, bestStake = i, f, stake
+		}
+	}
+	return best
+}
+
+/*
+ * Utils:
+ */
+
+// mergeRoots adds roots known by event to roots of frame.
+// Roots of lower frame are dropped.
+func mergeRoots(c Consensus, frame uint64, roots map[hash.Peer]struct{}, e hash.Event) (uint64, map[hash.Peer]struct{}) {
+	f, creators := c.KnownRoots(e)
+	if f < frame {
+		return frame, roots
+	}
+	if f > frame {
+		frame, roots = f, make(map[hash.Peer]struct{}, len(creators))
+	}
+	for _, p := range creators {
+		roots[p] = struct{}{}
+	}
+	return frame, roots
+}
+
+func copyRoots(roots map[hash.Peer]struct{}) map[hash.Peer]struct{} {
+	res := make(map[hash.Peer]struct{}, len(roots))
+	for p := range roots {
+		res[p] = struct{}{}
+	}
+	return res
+}
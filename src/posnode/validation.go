@@ -0,0 +1,212 @@
+package posnode
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+type (
+	// EventValidator checks downloaded event before it is saved.
+	EventValidator interface {
+		Validate(e *inter.Event) error
+	}
+
+	// EventValidatorFunc is an adapter to use func as EventValidator.
+	EventValidatorFunc func(e *inter.Event) error
+
+	// validation is a chain of event validators.
+	validation struct {
+		chain []EventValidator
+
+		sync.RWMutex
+	}
+)
+
+type (
+	// ErrTooManyParents is returned for event with parents count over the limit.
+	ErrTooManyParents struct {
+		Count, Limit int
+	}
+
+	// ErrEventTooLarge is returned for event with size over the limit.
+	ErrEventTooLarge struct {
+		Size, Limit int
+	}
+
+	// ErrTooManyTxns is returned for event with transactions count over the limit.
+	ErrTooManyTxns struct {
+		Count, Limit int
+	}
+
+	// ErrTxnTooLarge is returned for event with external transaction payload over the limit.
+	ErrTxnTooLarge struct {
+		Position, Size, Limit int
+	}
+
+	// ErrLamportTooFar is returned for event with lamport time too far ahead of its parents.
+	ErrLamportTooFar struct {
+		Time, Limit inter.Timestamp
+	}
+)
+
+// Validate calls f(e).
+func (f EventValidatorFunc) Validate(e *inter.Event) error {
+	return f(e)
+}
+
+// ParentsCountRule limits count of event's parents.
+func ParentsCountRule(limit int) EventValidator {
+	return EventValidatorFunc(func(e *inter.Event) error {
+		if count := len(e.Parents); count > limit {
+			return &ErrTooManyParents{count, limit}
+		}
+		return nil
+	})
+}
+
+// EventSizeRule limits size of serialized event.
+func EventSizeRule(limit int) EventValidator {
+	return EventValidatorFunc(func(e *inter.Event) error {
+		if size := proto.Size(e.ToWire()); size > limit {
+			return &ErrEventTooLarge{size, limit}
+		}
+		return nil
+	})
+}
+
+// TxnsCountRule limits count of event's internal and external transactions.
+func TxnsCountRule(limit int) EventValidator {
+	return EventValidatorFunc(func(e *inter.Event) error {
+		if count := len(e.InternalTransactions) + len(e.ExternalTransactions); count > limit {
+			return &ErrTooManyTxns{count, limit}
+		}
+		return nil
+	})
+}
+
+// TxnSizeRule limits payload size of each external transaction.
+func TxnSizeRule(limit int) EventValidator {
+	return EventValidatorFunc(func(e *inter.Event) error {
+		for i, txn := range e.ExternalTransactions {
+			if size := len(txn); size > limit {
+				return &ErrTxnTooLarge{i, size, limit}
+			}
+		}
+		return nil
+	})
+}
+
+// LamportAheadRule limits lamport time distance ahead of event's parents.
+// Only parents known by lamportOf() are taken into account,
+// if there are none of them the event is not limited here
+// (ordering buffer checks it strictly when parents come).
+func LamportAheadRule(ahead inter.Timestamp, lamportOf func(hash.Event) (inter.Timestamp, bool)) EventValidator {
+	return EventValidatorFunc(func(e *inter.Event) error {
+		var (
+			max   inter.Timestamp
+			known bool
+		)
+		for p := range e.Parents {
+			t, ok := lamportOf(p)
+			if !ok {
+				continue
+			}
+			known = true
+			if max < t {
+				max = t
+			}
+		}
+		if !known {
+			return nil
+		}
+
+		if limit := max + ahead; e.LamportTime > limit {
+			return &ErrLamportTooFar{e.LamportTime, limit}
+		}
+		return nil
+	})
+}
+
+func (e *ErrTooManyParents) Error() string {
+	return fmt.Sprintf("event has %d parents, limit is %d", e.Count, e.Limit)
+}
+
+func (e *ErrEventTooLarge) Error() string {
+	return fmt.Sprintf("event size is %d bytes, limit is %d", e.Size, e.Limit)
+}
+
+func (e *ErrTooManyTxns) Error() string {
+	return fmt.Sprintf("event has %d transactions, limit is %d", e.Count, e.Limit)
+}
+
+func (e *ErrTxnTooLarge) Error() string {
+	return fmt.Sprintf("event transaction %d size is %d bytes, limit is %d", e.Position, e.Size, e.Limit)
+}
+
+func (e *ErrLamportTooFar) Error() string {
+	return fmt.Sprintf("event lamport time %d is ahead of parents, limit is %d", e.Time, e.Limit)
+}
+
+func (n *Node) initValidation() {
+	n.validation.Lock()
+	defer n.validation.Unlock()
+
+	if n.validation.chain != nil {
+		return
+	}
+
+	n.validation.chain = []EventValidator{
+		ParentsCountRule(n.conf.EventParentsCount),
+		EventSizeRule(n.conf.EventMaxSize),
+		TxnsCountRule(n.conf.EventMaxTxns),
+		TxnSizeRule(n.conf.EventMaxTxnSize),
+		LamportAheadRule(n.conf.EventLamportAhead, n.lamportOf),
+	}
+}
+
+// AddEventValidator appends validator to the chain.
+func (n *Node) AddEventValidator(v EventValidator) {
+	n.initValidation()
+
+	n.validation.Lock()
+	defer n.validation.Unlock()
+
+	n.validation.chain = append(n.validation.chain, v)
+}
+
+// validateEvent runs validators chain on event downloaded from peer.
+// Invalid event is counted in peer reputation.
+func (n *Node) validateEvent(peer *Peer, e *inter.Event) error {
+	n.initValidation()
+
+	n.validation.RLock()
+	chain := n.validation.chain
+	n.validation.RUnlock()
+
+	for _, v := range chain {
+		if err := v.Validate(e); err != nil {
+			n.InvalidEvent(peer, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lamportOf returns lamport time of known event.
+func (n *Node) lamportOf(h hash.Event) (inter.Timestamp, bool) {
+	if h == hash.ZeroEvent {
+		return 0, true
+	}
+
+	e := n.store.GetEvent(h)
+	if e == nil {
+		return 0, false
+	}
+	return e.LamportTime, true
+}
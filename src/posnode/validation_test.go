@@ -0,0 +1,120 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestEventValidation(t *testing.T) {
+	store := NewMemStore()
+	node := NewForTests("validator", store, nil)
+	node.conf.EventMaxSize = 1024
+	node.conf.EventMaxTxns = 2
+	node.conf.EventMaxTxnSize = 10
+	node.conf.EventLamportAhead = 10
+	node.initPeers()
+
+	peer := &Peer{
+		ID:   hash.FakePeer(),
+		Host: "peer",
+	}
+
+	event := func(parents int, lamport inter.Timestamp, txns ...[]byte) *inter.Event {
+		e := &inter.Event{
+			Index:                1,
+			Creator:              hash.FakePeer(),
+			Parents:              hash.Events{},
+			LamportTime:          lamport,
+			ExternalTransactions: txns,
+		}
+		for i := 0; i < parents; i++ {
+			e.Parents.Add(hash.FakeEvent())
+		}
+		return e
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := node.validateEvent(peer, event(3, 10, []byte("tx")))
+		assert.NoError(err)
+	})
+
+	t.Run("too many parents", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := node.validateEvent(peer, event(4, 1))
+		assert.IsType(&ErrTooManyParents{}, err)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := node.validateEvent(peer, event(1, 1, make([]byte, 2048)))
+		assert.IsType(&ErrEventTooLarge{}, err)
+	})
+
+	t.Run("too many txns", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := node.validateEvent(peer, event(1, 1, nil, nil, nil))
+		assert.IsType(&ErrTooManyTxns{}, err)
+	})
+
+	t.Run("too large txn", func(t *testing.T) {
+		assert := assert.New(t)
+
+		err := node.validateEvent(peer, event(1, 1, nil, make([]byte, 11)))
+		assert.IsType(&ErrTxnTooLarge{}, err)
+	})
+
+	t.Run("lamport too far", func(t *testing.T) {
+		assert := assert.New(t)
+
+		parent := event(0, 10)
+		parent.Parents.Add(hash.ZeroEvent)
+		store.SetEvent(parent)
+
+		e := event(1, 21)
+		e.Parents.Add(parent.Hash())
+		err := node.validateEvent(peer, e)
+		assert.IsType(&ErrLamportTooFar{}, err)
+
+		e = event(0, 20)
+		e.Parents.Add(parent.Hash())
+		assert.NoError(node.validateEvent(peer, e), "bounded by parent")
+
+		e = event(0, 11)
+		e.Parents.Add(hash.ZeroEvent)
+		err = node.validateEvent(peer, e)
+		assert.IsType(&ErrLamportTooFar{}, err, "the first event")
+
+		err = node.validateEvent(peer, event(1, 100))
+		assert.NoError(err, "parents are unknown")
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		assert := assert.New(t)
+
+		node.AddEventValidator(EventValidatorFunc(func(e *inter.Event) error {
+			if len(e.ExternalTransactions) == 0 {
+				return &ErrTooManyTxns{0, 0}
+			}
+			return nil
+		}))
+
+		err := node.validateEvent(peer, event(1, 1))
+		assert.Error(err)
+	})
+
+	t.Run("counted", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(uint64(7), node.PeerReputation(peer.ID).InvalidData)
+		assert.Equal(uint64(0), node.PeerReputation(hash.FakePeer()).InvalidData)
+	})
+}
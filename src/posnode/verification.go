@@ -0,0 +1,115 @@
+package posnode
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/hashicorp/golang-lru"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+const verifiedCacheSize = 10000
+
+var (
+	// ErrUnknownCreator is returned for event of creator without known public key.
+	ErrUnknownCreator = errors.New("event creator's public key is unknown")
+	// ErrUnsignedEvent is returned for event without sign.
+	ErrUnsignedEvent = errors.New("event is not signed")
+	// ErrInvalidSign is returned for event with sign not of creator.
+	ErrInvalidSign = errors.New("event sign is invalid")
+)
+
+// verification caches verified events.
+type verification struct {
+	verified *lru.Cache
+	sync.Mutex
+}
+
+func (n *Node) initVerification() {
+	n.verification.Lock()
+	defer n.verification.Unlock()
+
+	if n.verification.verified != nil {
+		return
+	}
+
+	var err error
+	n.verification.verified, err = lru.New(verifiedCacheSize)
+	if err != nil {
+		n.Fatal(err)
+	}
+}
+
+// creatorKey returns public key of event creator
+// from peer store or from validators registry.
+func (n *Node) creatorKey(id hash.Peer) *common.PublicKey {
+	if id == n.ID {
+		return n.pub
+	}
+
+	if peer := n.store.GetPeer(id); peer != nil && peer.PubKey != nil {
+		return peer.PubKey
+	}
+
+	if n.consensus != nil {
+		return n.consensus.GetValidatorKey(id)
+	}
+
+	return nil
+}
+
+// verifyEvent checks event is signed by its creator.
+// Each event is verified once, result is cached.
+func (n *Node) verifyEvent(e *inter.Event) error {
+	n.initVerification()
+
+	h := e.Hash()
+	if n.verification.verified.Contains(h) {
+		return nil
+	}
+
+	if e.Sign == "" {
+		return ErrUnsignedEvent
+	}
+
+	key := n.creatorKey(e.Creator)
+	if key == nil {
+		return ErrUnknownCreator
+	}
+
+	if !e.Verify(key) {
+		return ErrInvalidSign
+	}
+
+	n.verification.verified.Add(h, struct{}{})
+	return nil
+}
+
+// verifyEvents checks events in parallel, it is for bulk sync.
+// Returns verification error for each event.
+func (n *Node) verifyEvents(events []*inter.Event) []error {
+	errs := make([]error, len(events))
+
+	threads := runtime.NumCPU()
+	if threads > len(events) {
+		threads = len(events)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(threads)
+	for t := 0; t < threads; t++ {
+		go func(t int) {
+			defer wg.Done()
+			for i := t; i < len(events); i += threads {
+				errs[i] = n.verifyEvent(events[i])
+			}
+		}(t)
+	}
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,112 @@
+package posnode
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestEventVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := NewMemStore()
+	consensus := NewMockConsensus(ctrl)
+	node := NewForTests("verifier", store, consensus)
+
+	known := crypto.GenerateKey()
+	store.SetPeer(&Peer{
+		ID:     hash.PeerOfPubkey(known.Public()),
+		PubKey: known.Public(),
+		Host:   "known",
+	})
+
+	validator := crypto.GenerateKey()
+	consensus.EXPECT().
+		GetValidatorKey(hash.PeerOfPubkey(validator.Public())).
+		Return(validator.Public()).
+		Times(1)
+
+	unknown := crypto.GenerateKey()
+	consensus.EXPECT().
+		GetValidatorKey(hash.PeerOfPubkey(unknown.Public())).
+		Return(nil).
+		AnyTimes()
+
+	signed := func(index uint64, creator, signer *common.PrivateKey) *inter.Event {
+		e := &inter.Event{
+			Index:   index,
+			Creator: hash.PeerOfPubkey(creator.Public()),
+			Parents: hash.NewEvents(hash.ZeroEvent),
+		}
+		if signer != nil {
+			if err := e.SignBy(signer); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return e
+	}
+
+	t.Run("peer store key", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.NoError(node.verifyEvent(signed(1, known, known)))
+	})
+
+	t.Run("validator key", func(t *testing.T) {
+		assert := assert.New(t)
+
+		e := signed(1, validator, validator)
+		assert.NoError(node.verifyEvent(e))
+		assert.NoError(node.verifyEvent(e), "cached")
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(ErrUnsignedEvent, node.verifyEvent(signed(2, known, nil)))
+	})
+
+	t.Run("mis-signed", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(ErrInvalidSign, node.verifyEvent(signed(3, known, validator)))
+
+		e := signed(4, known, known)
+		e.Sign = "garbage"
+		assert.Equal(ErrInvalidSign, node.verifyEvent(e))
+	})
+
+	t.Run("unknown creator", func(t *testing.T) {
+		assert := assert.New(t)
+
+		assert.Equal(ErrUnknownCreator, node.verifyEvent(signed(1, unknown, unknown)))
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		assert := assert.New(t)
+
+		events := []*inter.Event{
+			signed(5, known, known),
+			signed(6, known, nil),
+			signed(7, known, known),
+			signed(8, known, unknown),
+			signed(2, unknown, unknown),
+		}
+
+		errs := node.verifyEvents(events)
+		assert.Equal([]error{
+			nil,
+			ErrUnsignedEvent,
+			nil,
+			ErrInvalidSign,
+			ErrUnknownCreator,
+		}, errs)
+	})
+}
@@ -1,21 +1,51 @@
 package posposet
 
 import (
+	"github.com/golang/protobuf/proto"
+
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
 	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
 )
 
-// Block is a chain block.
-type Block struct {
-	Index  uint64
-	Events hash.EventsSlice
-}
+type (
+	// Block is a chain block.
+	Block struct {
+		Index      uint64
+		Prev       hash.Hash // header hash of the previous block, genesis hash for the first
+		Frame      uint64
+		Atropos    hash.Event      // the last Atropos of block
+		Time       inter.Timestamp // consensus time of Atropos
+		EventsRoot hash.Hash       // merkle root of ordered events
+		Root       hash.Hash       // state root after block transactions are applied
+		Events     hash.EventsSlice
+	}
+
+	// BlockHeader is a block without events.
+	BlockHeader struct {
+		Index      uint64
+		Prev       hash.Hash
+		Frame      uint64
+		Atropos    hash.Event
+		Time       inter.Timestamp
+		EventsRoot hash.Hash
+		Root       hash.Hash
+	}
+)
 
 // ToWire converts to proto.Message.
 func (e *Block) ToWire() *wire.Block {
 	return &wire.Block{
-		Index:  e.Index,
-		Events: e.Events.ToWire(),
+		Index:      e.Index,
+		Prev:       e.Prev.Bytes(),
+		Frame:      e.Frame,
+		Atropos:    e.Atropos.Bytes(),
+		Time:       uint64(e.Time),
+		EventsRoot: e.EventsRoot.Bytes(),
+		Root:       e.Root.Bytes(),
+		Events:     e.Events.ToWire(),
 	}
 }
 
@@ -25,20 +55,124 @@ func WireToBlock(w *wire.Block) *Block {
 		return nil
 	}
 	return &Block{
-		Index:  w.Index,
-		Events: hash.WireToEventHashSlice(w.Events),
+		Index:      w.Index,
+		Prev:       hash.FromBytes(w.Prev),
+		Frame:      w.Frame,
+		Atropos:    hash.BytesToEventHash(w.Atropos),
+		Time:       inter.Timestamp(w.Time),
+		EventsRoot: hash.FromBytes(w.EventsRoot),
+		Root:       hash.FromBytes(w.Root),
+		Events:     hash.WireToEventHashSlice(w.Events),
 	}
 }
 
-// NewBlock makes main chain block from topological ordered events.
-func NewBlock(index uint64, ordered Events) *Block {
+// NewBlock makes main chain block from topological ordered events of frame.
+// The last event is the Atropos with consensus time.
+func NewBlock(index, frame uint64, ordered Events) *Block {
 	events := make(hash.EventsSlice, len(ordered))
 	for i, e := range ordered {
 		events[i] = e.Hash()
 	}
 
-	return &Block{
-		Index:  index,
-		Events: events,
+	b := &Block{
+		Index:      index,
+		Frame:      frame,
+		EventsRoot: eventsTrie(events).Hash(),
+		Events:     events,
+	}
+	if len(ordered) > 0 {
+		atropos := ordered[len(ordered)-1]
+		b.Atropos = atropos.Hash()
+		b.Time = atropos.consensusTime
+	}
+	return b
+}
+
+// Header returns block header.
+func (e *Block) Header() *BlockHeader {
+	return &BlockHeader{
+		Index:      e.Index,
+		Prev:       e.Prev,
+		Frame:      e.Frame,
+		Atropos:    e.Atropos,
+		Time:       e.Time,
+		EventsRoot: e.EventsRoot,
+		Root:       e.Root,
+	}
+}
+
+// ToWire converts to proto.Message.
+func (h *BlockHeader) ToWire() *wire.BlockHeader {
+	return &wire.BlockHeader{
+		Index:      h.Index,
+		Prev:       h.Prev.Bytes(),
+		Frame:      h.Frame,
+		Atropos:    h.Atropos.Bytes(),
+		Time:       uint64(h.Time),
+		EventsRoot: h.EventsRoot.Bytes(),
+		Root:       h.Root.Bytes(),
+	}
+}
+
+// WireToBlockHeader converts from wire.
+func WireToBlockHeader(w *wire.BlockHeader) *BlockHeader {
+	if w == nil {
+		return nil
+	}
+	return &BlockHeader{
+		Index:      w.Index,
+		Prev:       hash.FromBytes(w.Prev),
+		Frame:      w.Frame,
+		Atropos:    hash.BytesToEventHash(w.Atropos),
+		Time:       inter.Timestamp(w.Time),
+		EventsRoot: hash.FromBytes(w.EventsRoot),
+		Root:       hash.FromBytes(w.Root),
+	}
+}
+
+// Hash calcs hash of header.
+func (h *BlockHeader) Hash() hash.Hash {
+	var buf proto.Buffer
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(h.ToWire()); err != nil {
+		panic(err)
+	}
+	return hash.Of(buf.Bytes())
+}
+
+// GetBlock returns block by index.
+func (p *Poset) GetBlock(n uint64) *Block {
+	return p.store.GetBlock(n)
+}
+
+// prevBlockHash returns header hash of the last block.
+func (p *Poset) prevBlockHash() hash.Hash {
+	if p.state.LastBlockN < 1 {
+		return p.GetGenesisHash()
+	}
+	return p.store.GetBlock(p.state.LastBlockN).Header().Hash()
+}
+
+// lastBlockRoot returns state root of the last block (genesis if no blocks).
+func (p *Poset) lastBlockRoot() hash.Hash {
+	if p.state.LastBlockN < 1 {
+		return p.state.Genesis
+	}
+	return p.store.GetBlock(p.state.LastBlockN).Root
+}
+
+/*
+ * Utils:
+ */
+
+// eventsTrie makes merkle trie of event hashes by position.
+func eventsTrie(events hash.EventsSlice) *trie.Trie {
+	t, err := trie.New(hash.Hash{}, trie.NewDatabase(kvdb.NewMemDatabase()))
+	if err != nil {
+		panic(err)
+	}
+	for i, e := range events {
+		t.Update(intToBytes(uint64(i)), e.Bytes())
 	}
+	return t
 }
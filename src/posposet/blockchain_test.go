@@ -0,0 +1,83 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+)
+
+func TestPosetBlocks(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes, nodesEvents := GenEventsByNode(5, 50, 3)
+	p, _, input := FakePoset(nodes)
+	pushEvents(p, input, nodes, nodesEvents)
+
+	if !assert.NotZero(p.state.LastBlockN, "blocks are decided") {
+		return
+	}
+
+	var prev *Block
+	for n := uint64(1); n <= p.state.LastBlockN; n++ {
+		b := p.GetBlock(n)
+		if !assert.NotNil(b) {
+			return
+		}
+
+		assert.Equal(b.Atropos, b.Events[len(b.Events)-1], "Atropos is the last")
+		assert.NotZero(b.Time)
+		assert.NotZero(b.Frame)
+		if prev != nil {
+			assert.True(prev.Frame < b.Frame)
+			assert.True(prev.Time <= b.Time)
+		}
+		assert.Equal(b.Root, p.store.StateDB(b.Root).IntermediateRoot(true), "state is stored")
+
+		prev = b
+	}
+}
+
+func TestPosetBlockState(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+		nodes[0]: 10,
+		nodes[1]: 10,
+	}, &pos.Config{})
+
+	transfer := func(index uint64) Events {
+		e := &inter.Event{
+			Index:   index,
+			Creator: nodes[0],
+			Parents: hash.NewEvents(hash.ZeroEvent),
+			InternalTransactions: []*inter.InternalTransaction{{
+				Index:    index,
+				Amount:   3,
+				Receiver: nodes[1],
+			}},
+		}
+		input.SetEvent(e)
+		return Events{&Event{Event: e}}
+	}
+
+	// two Atroposes are decided while frame balances are the same
+	frame := p.frame(1, true)
+	frame.SetBalances(p.state.Genesis)
+	first := p.makeBlock(1, transfer(1))
+	second := p.makeBlock(1, transfer(2))
+
+	assert.Equal(p.state.Genesis, frame.Balances)
+	assert.Equal(first.Header().Hash(), second.Prev)
+
+	db := store.StateDB(first.Root)
+	assert.Equal(uint64(10-3), db.FreeBalance(nodes[0]))
+
+	db = store.StateDB(second.Root)
+	assert.Equal(uint64(10-3-3), db.FreeBalance(nodes[0]), "previous block changes are kept")
+	assert.Equal(uint64(10+3+3), db.FreeBalance(nodes[1]))
+}
@@ -0,0 +1,130 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+type (
+	// Cheaters is a set of peers caught on forks.
+	Cheaters map[hash.Peer]struct{}
+
+	// forkEvidence is a fork proof with known public key of the cheater.
+	forkEvidence struct {
+		proof *inter.ForkProof
+		key   *common.PublicKey
+	}
+)
+
+// Add appends cheater, returns false if it is known already.
+func (cc Cheaters) Add(addr hash.Peer) bool {
+	if _, ok := cc[addr]; ok {
+		return false
+	}
+	cc[addr] = struct{}{}
+	return true
+}
+
+// Contains returns true if peer is a cheater.
+func (cc Cheaters) Contains(addr hash.Peer) bool {
+	_, ok := cc[addr]
+	return ok
+}
+
+// ToWire converts to simple slice.
+func (cc Cheaters) ToWire() [][]byte {
+	res := make([][]byte, 0, len(cc))
+
+	for addr := range cc {
+		res = append(res, addr.Bytes())
+	}
+
+	return res
+}
+
+// WireToCheaters converts from wire.
+func WireToCheaters(arr [][]byte) Cheaters {
+	res := make(Cheaters, len(arr))
+
+	for _, buf := range arr {
+		res[hash.BytesToPeer(buf)] = struct{}{}
+	}
+
+	return res
+}
+
+/*
+ * Poset's methods:
+ */
+
+// PushForkProof takes cheating evidence into processing.
+// Key is a public key of the cheater known by peer id,
+// it is used if cheater has no registered key (genesis validator).
+func (p *Poset) PushForkProof(proof *inter.ForkProof, key *common.PublicKey) {
+	p.newProofsCh <- &forkEvidence{
+		proof: proof,
+		key:   key,
+	}
+}
+
+// GetCheaters returns peers caught on forks,
+// including the ones are not excluded from validators yet.
+func (p *Poset) GetCheaters() []hash.Peer {
+	p.cheatersMu.RLock()
+	defer p.cheatersMu.RUnlock()
+
+	res := make([]hash.Peer, 0, len(p.state.Cheaters)+len(p.state.NewCheaters))
+	for addr := range p.state.Cheaters {
+		res = append(res, addr)
+	}
+	for addr := range p.state.NewCheaters {
+		res = append(res, addr)
+	}
+	return res
+}
+
+// GetForkProof returns cheating evidence of peer.
+// If peer is not a cheater returns nil.
+func (p *Poset) GetForkProof(addr hash.Peer) *inter.ForkProof {
+	return p.store.GetForkProof(addr)
+}
+
+// applyForkProof stores evidence and marks cheater to be excluded
+// from validators at the next epoch seal, as proofs arrive in no particular order.
+// Both events should be signed by the creator's registered key
+// or by the peer key the creator id is derived from.
+// It is not safe for concurrent use.
+func (p *Poset) applyForkProof(proof *inter.ForkProof, peerKey *common.PublicKey) {
+	if proof == nil || inter.NewForkProof(proof.First, proof.Second) == nil {
+		p.Warn("Invalid fork proof. Skipped")
+		return
+	}
+
+	creator := proof.Creator()
+	if p.store.GetForkProof(creator) != nil {
+		return
+	}
+
+	key := p.GetValidatorKey(creator)
+	if key == nil && peerKey != nil && hash.PeerOfPubkey(peerKey) == creator {
+		key = peerKey
+	}
+	if key == nil {
+		p.Warnf("Fork proof of unknown key of %s. Skipped", creator.String())
+		return
+	}
+	if !proof.Verify(key) {
+		p.Warnf("Fork proof of %s has invalid sign. Skipped", creator.String())
+		return
+	}
+	p.store.SetForkProof(proof)
+
+	p.cheatersMu.Lock()
+	p.state.NewCheaters.Add(creator)
+	p.cheatersMu.Unlock()
+	p.saveState()
+
+	p.Warnf("Creator %s is a cheater: %s and %s have the same index %d",
+		creator.String(), proof.First.Hash().String(), proof.Second.Hash().String(), proof.First.Index)
+}
@@ -0,0 +1,123 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+)
+
+func TestPosetCheaters(t *testing.T) {
+	signed := func(e *inter.Event, key *common.PrivateKey) *inter.Event {
+		if err := e.SignBy(key); err != nil {
+			t.Fatal(err)
+		}
+		return e
+	}
+
+	t.Run("registered key", func(t *testing.T) {
+		assert := assert.New(t)
+
+		nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+		p, store, _ := FakePoset(nodes)
+
+		// invalid proof is skipped
+		p.applyForkProof(&inter.ForkProof{
+			First:  &inter.Event{Index: 1, Creator: nodes[0]},
+			Second: &inter.Event{Index: 1, Creator: nodes[0]},
+		}, nil)
+		assert.Empty(p.GetCheaters())
+
+		key := crypto.GenerateKey()
+		proof := inter.NewForkProof(
+			signed(&inter.Event{Index: 1, Creator: nodes[0]}, key),
+			signed(&inter.Event{Index: 1, Creator: nodes[0], LamportTime: 1}, key),
+		)
+
+		// proof of unknown key is skipped
+		p.applyForkProof(proof, key.Public())
+		assert.Empty(p.GetCheaters())
+
+		// proof signed by other key is skipped
+		commitValidatorKey(p, nodes[0], crypto.GenerateKey().Public().Bytes())
+		p.applyForkProof(proof, nil)
+		assert.Empty(p.GetCheaters())
+
+		commitValidatorKey(p, nodes[0], key.Public().Bytes())
+		p.applyForkProof(proof, nil)
+
+		assert.Equal([]hash.Peer{nodes[0]}, p.GetCheaters())
+		assert.Equal(proof.Second.Hash(), p.GetForkProof(nodes[0]).Second.Hash())
+		assert.Nil(p.GetForkProof(nodes[1]))
+
+		restored := store.GetState()
+		assert.True(restored.NewCheaters.Contains(nodes[0]))
+	})
+
+	t.Run("genesis validator", func(t *testing.T) {
+		assert := assert.New(t)
+
+		key := crypto.GenerateKey()
+		nodes := []hash.Peer{hash.PeerOfPubkey(key.Public()), hash.FakePeer(), hash.FakePeer()}
+		p, store, _ := fakePosetWithBalances(map[hash.Peer]uint64{
+			nodes[0]: 10,
+			nodes[1]: 1,
+			nodes[2]: 1,
+		}, &pos.Config{
+			EpochLen: 5,
+		})
+
+		roots := EventsByPeer{}
+		roots.AddOne(hash.FakeEvent(), nodes[0])
+		assert.True(p.hasMajority(roots))
+
+		proof := inter.NewForkProof(
+			signed(&inter.Event{Index: 1, Creator: nodes[0]}, key),
+			signed(&inter.Event{Index: 1, Creator: nodes[0], LamportTime: 1}, key),
+		)
+
+		// peer key of other node is not trusted
+		p.applyForkProof(proof, crypto.GenerateKey().Public())
+		assert.Empty(p.GetCheaters())
+
+		p.applyForkProof(proof, key.Public())
+		assert.Equal([]hash.Peer{nodes[0]}, p.GetCheaters())
+		assert.True(p.hasMajority(roots), "cheater's stake is counted until the epoch end")
+
+		p.frame(5, true).SetBalances(p.state.Genesis)
+		p.state.LastFinishedFrameN = 5
+		p.sealEpoch()
+
+		assert.Equal([]hash.Peer{nodes[0]}, p.GetCheaters())
+		assert.NotContains(p.GetValidators(), nodes[0])
+		assert.Equal(uint64(2), p.state.TotalCap)
+		assert.False(p.hasMajority(roots), "cheater's stake is excluded")
+
+		restored := store.GetState()
+		assert.True(restored.Cheaters.Contains(nodes[0]))
+		assert.Empty(restored.NewCheaters)
+	})
+}
+
+/*
+ * Utils:
+ */
+
+// commitValidatorKey registers validator key in the new block state.
+func commitValidatorKey(p *Poset, addr hash.Peer, pubKey []byte) {
+	db := p.committedState()
+	db.SetValidatorKey(addr, pubKey)
+	root, err := db.Commit(true)
+	if err != nil {
+		panic(err)
+	}
+
+	p.state.LastBlockN++
+	p.store.SetBlock(&Block{Index: p.state.LastBlockN, Root: root})
+	p.saveState()
+}
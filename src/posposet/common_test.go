@@ -23,7 +23,7 @@ func FakePoset(nodes []hash.Peer) (*Poset, *Store, *EventStore) {
 
 	input := NewEventStore(nil, false)
 
-	poset := New(store, input)
+	poset := New(store, input, nil, nil)
 	poset.Bootstrap()
 	MakeOrderedInput(poset)
 
@@ -0,0 +1,82 @@
+package posposet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+type (
+	// Config is a set of consensus params. It should be the same for all the net nodes.
+	Config struct {
+		BalancesDelay uint64   `mapstructure:"balances-delay"` // count of frames after root frame to apply block balances at
+		FinalityGap   uint64   `mapstructure:"finality-gap"`   // min count of frames after frame to decide its Atroposes
+		Majority      Fraction `mapstructure:"majority"`       // part of total stake to become root or Atropos
+		Trust         Fraction `mapstructure:"trust"`          // part of total stake to become Clotho candidate
+		EventsBuffer  int      `mapstructure:"events-buffer"`  // count of incoming events waiting for processing
+	}
+
+	// Fraction is a Num/Den part of value.
+	Fraction struct {
+		Num uint64 `mapstructure:"num"`
+		Den uint64 `mapstructure:"den"`
+	}
+)
+
+// DefaultConfig returns default consensus params.
+func DefaultConfig() *Config {
+	return &Config{
+		BalancesDelay: 3,
+		FinalityGap:   3,
+		Majority:      Fraction{2, 3},
+		Trust:         Fraction{1, 3},
+		EventsBuffer:  10,
+	}
+}
+
+// Validate returns error if params are inconsistent.
+func (c *Config) Validate() error {
+	if err := c.Majority.Validate(); err != nil {
+		return fmt.Errorf("majority: %s", err)
+	}
+	if err := c.Trust.Validate(); err != nil {
+		return fmt.Errorf("trust: %s", err)
+	}
+	return nil
+}
+
+// Hash returns hash of params which consensus depends on.
+func (c *Config) Hash() hash.Hash {
+	var buf bytes.Buffer
+	for _, v := range []uint64{
+		c.BalancesDelay,
+		c.FinalityGap,
+		c.Majority.Num, c.Majority.Den,
+		c.Trust.Num, c.Trust.Den,
+	} {
+		_ = binary.Write(&buf, binary.BigEndian, v)
+	}
+	return hash.Of(buf.Bytes())
+}
+
+// Validate returns error if fraction is not a part of whole.
+func (f Fraction) Validate() error {
+	if f.Den == 0 {
+		return errors.New("zero denominator")
+	}
+	if f.Num > f.Den {
+		return fmt.Errorf("%d/%d is greater than 1", f.Num, f.Den)
+	}
+	return nil
+}
+
+// Of returns the part of value.
+func (f Fraction) Of(value uint64) uint64 {
+	if f.Den == 0 {
+		return 0
+	}
+	return value * f.Num / f.Den
+}
@@ -0,0 +1,66 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+)
+
+func TestConfigGenesis(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	p, store, input := FakePoset(nodes)
+
+	same := New(store, input, nil, DefaultConfig())
+	same.Bootstrap()
+	assert.Equal(p.GetGenesisHash(), same.GetGenesisHash(), "same params")
+
+	params := DefaultConfig()
+	params.Majority = Fraction{3, 4}
+	other := New(store, input, nil, params)
+	other.Bootstrap()
+	assert.NotEqual(p.GetGenesisHash(), other.GetGenesisHash(), "other params")
+
+	conf := pos.DefaultConfig()
+	conf.BlockReward++
+	otherPoS := New(store, input, conf, nil)
+	otherPoS.Bootstrap()
+	assert.NotEqual(p.GetGenesisHash(), otherPoS.GetGenesisHash(), "other PoS params")
+
+	params = DefaultConfig()
+	params.EventsBuffer *= 2
+	local := New(store, input, nil, params)
+	local.Bootstrap()
+	assert.Equal(p.GetGenesisHash(), local.GetGenesisHash(), "buffer size is a local param")
+}
+
+func TestFraction(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(uint64(6), Fraction{2, 3}.Of(9))
+	assert.Equal(uint64(3), Fraction{1, 3}.Of(10))
+	assert.Equal(uint64(0), Fraction{1, 0}.Of(10), "zero denominator")
+
+	assert.NoError(Fraction{2, 3}.Validate())
+	assert.NoError(Fraction{3, 3}.Validate())
+	assert.Error(Fraction{1, 0}.Validate())
+	assert.Error(Fraction{4, 3}.Validate())
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NoError(DefaultConfig().Validate())
+
+	params := DefaultConfig()
+	params.Majority = Fraction{2, 0}
+	assert.Error(params.Validate())
+
+	params = DefaultConfig()
+	params.Trust = Fraction{4, 3}
+	assert.Error(params.Validate())
+}
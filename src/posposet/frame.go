@@ -43,6 +43,19 @@ func (f *Frame) AddClothoCandidate(event hash.Event, creator hash.Peer) {
 	}
 }
 
+// DelClothoCandidate removes event from ClothoCandidates list.
+func (f *Frame) DelClothoCandidate(event hash.Event, creator hash.Peer) {
+	events := f.ClothoCandidates[creator]
+	if !events.Contains(event) {
+		return
+	}
+	delete(events, event)
+	if len(events) < 1 {
+		delete(f.ClothoCandidates, creator)
+	}
+	f.Save()
+}
+
 // SetAtropos makes Atropos from Clotho and consensus time.
 func (f *Frame) SetAtropos(clotho hash.Event, consensusTime inter.Timestamp) {
 	if t, ok := f.Atroposes[clotho]; ok && t == consensusTime {
@@ -52,6 +52,9 @@ func (p *Poset) FrameOfEvent(event hash.Event) (frame *Frame, isRoot bool) {
 	}
 
 	frame = p.frame(*fnum, false)
+	if frame == nil {
+		return
+	}
 	knowns := frame.FlagTable[event]
 	for _, events := range knowns {
 		if events.Contains(event) {
@@ -63,6 +66,25 @@ func (p *Poset) FrameOfEvent(event hash.Event) (frame *Frame, isRoot bool) {
 	return
 }
 
+// KnownRoots returns frame of event and creators of the frame roots known by event.
+// It reads store only, so it is safe for concurrent use.
+func (p *Poset) KnownRoots(event hash.Event) (frame uint64, creators []hash.Peer) {
+	fnum := p.store.GetEventFrame(event)
+	if fnum == nil {
+		return
+	}
+	f := p.store.GetFrame(*fnum)
+	if f == nil {
+		return
+	}
+
+	frame = f.Index
+	for creator := range f.FlagTable[event] {
+		creators = append(creators, creator)
+	}
+	return
+}
+
 // frame finds or creates frame.
 func (p *Poset) frame(n uint64, orCreate bool) *Frame {
 	if n < p.state.LastFinishedFrameN && orCreate {
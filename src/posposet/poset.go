@@ -1,12 +1,15 @@
 package posposet
 
 import (
+	"context"
+	"reflect"
 	"sort"
 	"sync"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/logger"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
 )
 
 // Poset processes events to get consensus.
@@ -15,29 +18,44 @@ type Poset struct {
 	state  *State
 	input  EventSource
 	frames map[uint64]*Frame
+	conf   pos.Config
+	params Config
+
+	decidedBy Validators // stakes of the last root decisions
 
 	processingWg   sync.WaitGroup
 	processingDone chan struct{}
 
 	newEventsCh chan hash.Event
 	onNewEvent  func(*inter.Event) // onNewEvent runs consensus calc from new event
+	newProofsCh chan *forkEvidence
+	cheatersMu  sync.RWMutex
 
-	NewBlockCh chan uint64
+	subscribers   map[*Subscription]struct{}
+	subscribersMu sync.Mutex
 
 	logger.Instance
 }
 
 // New creates Poset instance.
 // It does not start any process.
-func New(store *Store, input EventSource) *Poset {
-	const buffSize = 10
+func New(store *Store, input EventSource, conf *pos.Config, params *Config) *Poset {
+	if conf == nil {
+		conf = pos.DefaultConfig()
+	}
+	if params == nil {
+		params = DefaultConfig()
+	}
 
 	p := &Poset{
 		store:  store,
 		input:  input,
 		frames: make(map[uint64]*Frame),
+		conf:   *conf,
+		params: *params,
 
-		newEventsCh: make(chan hash.Event, buffSize),
+		newEventsCh: make(chan hash.Event, params.EventsBuffer),
+		newProofsCh: make(chan *forkEvidence, params.EventsBuffer),
 
 		Instance: logger.MakeInstance(),
 	}
@@ -74,6 +92,8 @@ func (p *Poset) Start() {
 			case e := <-p.newEventsCh:
 				event := p.input.GetEvent(e)
 				p.onNewEvent(event)
+			case fork := <-p.newProofsCh:
+				p.applyForkProof(fork.proof, fork.key)
 			}
 		}
 	}()
@@ -87,21 +107,51 @@ func (p *Poset) Stop() {
 	close(p.processingDone)
 	p.processingWg.Wait()
 	p.processingDone = nil
+
+	p.unsubscribeAll()
 }
 
 // PushEvent takes event into processing.
 // Event order matter: parents first.
+// It blocks while events queue is full, so caller should watch Backpressure()
+// or use TryPushEvent().
 func (p *Poset) PushEvent(e hash.Event) {
 	p.newEventsCh <- e
 }
 
+// TryPushEvent takes event into processing as PushEvent does,
+// but waits for room in events queue until ctx is done.
+// It does not wait with done ctx. Returns ctx error if event is not taken.
+func (p *Poset) TryPushEvent(ctx context.Context, e hash.Event) error {
+	select {
+	case p.newEventsCh <- e:
+		return nil
+	default:
+	}
+
+	select {
+	case p.newEventsCh <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Backpressure returns fill ratio [0..1] of events queue.
+func (p *Poset) Backpressure() float64 {
+	return float64(len(p.newEventsCh)) / float64(cap(p.newEventsCh))
+}
+
 // consensus is not safe for concurrent use.
 func (p *Poset) consensus(event *inter.Event) {
 	e := &Event{
 		Event: event,
 	}
 
-	const X = 3 // TODO: move this magic number to mainnet config
+	if !p.isValidator(e.Creator) {
+		p.Warnf("Creator %s of %s is not a validator of epoch %d. Skipped", e.Creator.String(), e.String(), p.state.Epoch)
+		return
+	}
 
 	var frame *Frame
 	if frame = p.checkIfRoot(e); frame == nil {
@@ -109,38 +159,28 @@ func (p *Poset) consensus(event *inter.Event) {
 	}
 	p.setClothoCandidates(e, frame)
 
+	p.processFrames(frame)
+}
+
+// processFrames makes blocks of matured frames up to the frame of the last root
+// and applies the last block state to balances.
+// It is not safe for concurrent use.
+func (p *Poset) processFrames(frame *Frame) {
+	// balances changes
+	applyAt := p.frame(frame.Index+p.params.BalancesDelay, true)
+
 	// process matured frames where ClothoCandidates have become Clothos
-	var ordered Events
 	lastFinished := p.state.LastFinishedFrameN
-	for n := p.state.LastFinishedFrameN + 1; n+3 <= frame.Index; n++ {
+	for n := p.state.LastFinishedFrameN + 1; n+p.params.FinalityGap <= frame.Index; n++ {
 		if p.hasAtropos(n, frame.Index) {
-			// make new block
-			events := p.topologicalOrdered(n)
-			block := NewBlock(p.state.LastBlockN+1, events)
-			p.store.SetBlock(block)
-			p.state.LastBlockN = block.Index
-			p.saveState()
-			if p.NewBlockCh != nil {
-				p.NewBlockCh <- p.state.LastBlockN
-			}
-
 			// TODO: fix it
 			lastFinished = n // NOTE: are every event of prev frame there in block? (No)
 
-			ordered = append(ordered, events...)
+			p.makeBlock(n, p.topologicalOrdered(n))
 		}
 	}
 
-	// balances changes
-	applyAt := p.frame(frame.Index+X, true)
-	state := p.store.StateDB(frame.Balances)
-	applyTransactions(state, ordered)
-	applyRewards(state, ordered)
-	balances, err := state.Commit(true)
-	if err != nil {
-		p.Fatal(err)
-	}
-	if applyAt.SetBalances(balances) {
+	if applyAt.SetBalances(p.lastBlockRoot()) {
 		p.reconsensusFromFrame(applyAt.Index)
 	}
 
@@ -148,20 +188,61 @@ func (p *Poset) consensus(event *inter.Event) {
 	if p.state.LastFinishedFrameN < lastFinished {
 		p.state.LastFinishedFrameN = lastFinished
 		p.saveState()
+		p.sealEpoch()
 	}
 
 	// clean old frames
 	for i := range p.frames {
-		if i+X < p.state.LastFinishedFrameN {
+		if i+p.params.BalancesDelay < p.state.LastFinishedFrameN {
 			delete(p.frames, i)
 		}
 	}
 }
 
+// makeBlock makes new block of frame events.
+// Block state is the previous block state (genesis for the first block)
+// with the block transactions and rewards applied.
+// It is not safe for concurrent use.
+func (p *Poset) makeBlock(frameN uint64, events Events) *Block {
+	block := NewBlock(p.state.LastBlockN+1, frameN, events)
+	block.Prev = p.prevBlockHash()
+
+	state := p.store.StateDB(p.lastBlockRoot())
+	p.applyTransactions(state, block.Index, events)
+	p.applyRewards(state, events)
+	root, err := state.Commit(true)
+	if err != nil {
+		p.Fatal(err)
+	}
+	block.Root = root
+
+	p.store.SetBlock(block)
+	p.state.LastBlockN = block.Index
+	p.saveState()
+
+	p.notifyBlock(block, frameN)
+	return block
+}
+
+// rootDecision is a result of root-conditions check.
+type rootDecision struct {
+	known  eventsByFrame // roots known by event
+	frames []uint64      // frames to note known roots at
+	frame  uint64        // frame of event
+	isRoot bool
+}
+
 // checkIfRoot checks root-conditions for new event
 // and returns frame where event is root.
 // It is not safe for concurrent use.
 func (p *Poset) checkIfRoot(e *Event) *Frame {
+	return p.applyRootDecision(e, p.decideRoot(e, nil))
+}
+
+// decideRoot checks root-conditions for event without frames changing.
+// Loaded is an optional set of events to not read them from input again.
+// It is not safe for concurrent use.
+func (p *Poset) decideRoot(e *Event, loaded map[hash.Event]*Event) (d rootDecision) {
 	//log.Debugf("----- %s", e)
 	knownRoots := eventsByFrame{}
 	minFrame := p.state.LastFinishedFrameN + 1
@@ -173,7 +254,11 @@ func (p *Poset) checkIfRoot(e *Event) *Frame {
 				// NOTE: is it possible some participants got this event before parent outdated?
 				continue
 			}
-			if prev := p.GetEvent(parent); prev.Creator == e.Creator {
+			prev, ok := loaded[parent]
+			if !ok {
+				prev = p.GetEvent(parent)
+			}
+			if prev.Creator == e.Creator {
 				minFrame = frame.Index
 			}
 			roots := frame.GetRootsOf(parent)
@@ -190,29 +275,44 @@ func (p *Poset) checkIfRoot(e *Event) *Frame {
 		}
 	}
 
-	var (
-		frame  *Frame
-		isRoot bool
-	)
+	d.known = knownRoots
 	for _, fnum := range knownRoots.FrameNumsDesc() {
 		if fnum < minFrame {
 			break
 		}
-		roots := knownRoots[fnum]
-		frame = p.frame(fnum, true)
-		frame.AddRootsOf(e.Hash(), roots)
-		//log.Debugf(" %s knows %s at frame %d", e.Hash().String(), roots.String(), frame.Index)
-		if isRoot = p.hasMajority(frame, roots); isRoot {
-			frame = p.frame(fnum+1, true)
-			//log.Debugf(" %s is root of frame %d", e.Hash().String(), frame.Index)
+		d.frames = append(d.frames, fnum)
+		d.frame = fnum
+		//log.Debugf(" %s knows %s at frame %d", e.Hash().String(), knownRoots[fnum].String(), fnum)
+		if d.isRoot = p.hasMajority(knownRoots[fnum]); d.isRoot {
+			d.frame = fnum + 1
+			//log.Debugf(" %s is root of frame %d", e.Hash().String(), d.frame)
 			break
 		}
 	}
+	return
+}
+
+// applyRootDecision notes roots known by event into frames
+// and returns frame where event is root.
+// It is not safe for concurrent use.
+func (p *Poset) applyRootDecision(e *Event, d rootDecision) *Frame {
+	var frame *Frame
+	for _, fnum := range d.frames {
+		frame = p.frame(fnum, true)
+		frame.AddRootsOf(e.Hash(), d.known[fnum])
+	}
+	if d.isRoot {
+		frame = p.frame(d.frame, true)
+	}
+	if frame == nil {
+		// no roots of unfinished frames are known
+		return nil
+	}
 	if !p.isEventValid(e, frame) {
 		return nil
 	}
 	p.store.SetEventFrame(e.Hash(), frame.Index)
-	if isRoot {
+	if d.isRoot {
 		frame.AddRootsOf(e.Hash(), rootFrom(e))
 		return frame
 	}
@@ -242,7 +342,7 @@ func (p *Poset) setClothoCandidates(root *Event, frame *Frame) {
 			}
 		}
 		// check CC-condition
-		if p.hasTrust(frame, roots) {
+		if p.hasTrust(roots) {
 			prev.AddClothoCandidate(seen, seenCreator)
 			//log.Debugf("CC: %s from %s", seen.String(), seenCreator.String())
 		}
@@ -292,7 +392,7 @@ CLOTHO:
 						}
 					}
 
-					if diff%3 > 0 && p.hasMajority(prev, K) {
+					if diff%3 > 0 && p.hasMajority(K) {
 						//log.Debugf("ATROPOS %s of frame %d", clotho.String(), frame.Index)
 						frame.SetAtropos(clotho, T)
 						has = true
@@ -341,6 +441,10 @@ func (p *Poset) collectParents(a *Event, res *Events, already hash.Events) {
 			continue
 		}
 		f, _ := p.FrameOfEvent(hash)
+		if f == nil {
+			// frame is cleaned, so event is in prev blocks
+			continue
+		}
 		if _, ok := f.Atroposes[hash]; ok {
 			continue
 		}
@@ -353,38 +457,209 @@ func (p *Poset) collectParents(a *Event, res *Events, already hash.Events) {
 	}
 }
 
-// reconsensusFromFrame recalcs consensus of frames.
+// reconsensusFromFrame recalcs consensus of frames after stake snapshot change.
+// Only events whose root decision has changed (or known roots of their parents)
+// are noted again, then Clotho candidates of the frames are rechecked.
 // It is not safe for concurrent use.
 func (p *Poset) reconsensusFromFrame(start uint64) {
+	// stake-weighted outcomes are the same
+	if p.decidedBy != nil && p.decidedBy.Equal(p.state.Validators) {
+		return
+	}
+	p.decidedBy = p.GetValidators()
+
 	stop := p.frameNumLast()
-	var all inter.Events
-	// foreach stale frame
+	if start > stop {
+		return
+	}
+
+	// events noted in the frames
+	collected := make(map[hash.Event]*Event)
 	for n := start; n <= stop; n++ {
 		frame := p.frames[n]
-		// extract events
+		if frame == nil {
+			continue
+		}
 		for e := range frame.FlagTable {
-			if !frame.FlagTable.IsRoot(e) {
-				all = append(all, p.GetEvent(e).Event)
+			if _, ok := collected[e]; !ok {
+				collected[e] = p.GetEvent(e)
 			}
 		}
-		// and replace stale frame with blank
-		p.frames[n] = &Frame{
-			Index:            n,
-			FlagTable:        FlagTable{},
-			ClothoCandidates: EventsByPeer{},
-			Atroposes:        TimestampsByEvent{},
-			Balances:         frame.Balances,
+	}
+
+	// save each frame once, after all
+	for n := start; n <= stop; n++ {
+		if frame := p.frames[n]; frame != nil {
+			frame.save = nil
 		}
 	}
-	// recalc consensus
-	for _, e := range all.ByParents() {
-		p.consensus(e)
+
+	changed := hash.Events{}
+	for _, h := range parentsFirst(collected) {
+		e := collected[h]
+		if p.hasFinishedParents(e) {
+			// decision is based on finished frames, so keep it as noted
+			continue
+		}
+		d := p.decideRoot(e, collected)
+		if !containsAny(changed, e.Parents) && p.isSameDecision(h, d) {
+			continue
+		}
+
+		prev := p.notedRootsOf(h, start, stop)
+		p.forgetRootsOf(h, start, stop)
+		p.applyRootDecision(e, d)
+		if !reflect.DeepEqual(prev, p.notedRootsOf(h, start, stop)) {
+			changed.Add(h)
+		}
+	}
+	for n := start; n <= stop; n++ {
+		if frame := p.frames[n]; frame != nil {
+			p.setFrameSaving(frame)
+			if len(changed) > 0 {
+				frame.Save()
+			}
+		}
+	}
+	if len(changed) < 1 {
+		return
+	}
+
+	from := start
+	if from > 0 {
+		from--
+	}
+	if from <= p.state.LastFinishedFrameN {
+		from = p.state.LastFinishedFrameN + 1
+	}
+	// new roots could make a new frame
+	stop = p.frameNumLast()
+	p.recheckClothoCandidates(from, stop)
+
+	// continue with the frame of the last root
+	for n := stop; n >= start; n-- {
+		if frame := p.frames[n]; frame != nil && len(frame.FlagTable.Roots()) > 0 {
+			p.processFrames(frame)
+			break
+		}
+	}
+}
+
+// hasFinishedParents returns true if some of event parents are out of unfinished frames.
+func (p *Poset) hasFinishedParents(e *Event) bool {
+	for parent := range e.Parents {
+		if parent.IsZero() {
+			continue
+		}
+		fnum := p.store.GetEventFrame(parent)
+		if fnum == nil || *fnum <= p.state.LastFinishedFrameN {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameDecision returns true if event is noted already according to decision.
+func (p *Poset) isSameDecision(e hash.Event, d rootDecision) bool {
+	fnum := p.store.GetEventFrame(e)
+	if fnum == nil || *fnum != d.frame {
+		return false
+	}
+	frame := p.frames[*fnum]
+	return frame != nil && frame.FlagTable.IsRoot(e) == d.isRoot
+}
+
+// notedRootsOf returns roots known by event, which are noted in frames.
+func (p *Poset) notedRootsOf(e hash.Event, start, stop uint64) eventsByFrame {
+	res := eventsByFrame{}
+	for n := start; n <= stop; n++ {
+		if frame := p.frames[n]; frame != nil && frame.FlagTable[e] != nil {
+			res[n] = frame.FlagTable[e]
+		}
 	}
-	// foreach fresh frame
+	return res
+}
+
+// forgetRootsOf removes roots known by event from frames.
+func (p *Poset) forgetRootsOf(e hash.Event, start, stop uint64) {
 	for n := start; n <= stop; n++ {
 		frame := p.frames[n]
-		// save fresh frame
-		p.setFrameSaving(frame)
+		if frame == nil || frame.FlagTable[e] == nil {
+			continue
+		}
+		delete(frame.FlagTable, e)
 		frame.Save()
 	}
 }
+
+// recheckClothoCandidates checks clotho-conditions of frames roots again.
+// It is not safe for concurrent use.
+func (p *Poset) recheckClothoCandidates(start, stop uint64) {
+	for n := start; n < stop; n++ {
+		frame, next := p.frames[n], p.frames[n+1]
+		if frame == nil || next == nil {
+			continue
+		}
+
+		roots := frame.FlagTable.Roots()
+		for cc, creator := range frame.ClothoCandidates.Each() {
+			if !roots.Contains(creator, cc) {
+				frame.DelClothoCandidate(cc, creator)
+			}
+		}
+
+		nextRoots := next.FlagTable.Roots()
+		for seen, seenCreator := range roots.Each() {
+			// all roots from next frame, reach the seen
+			knowing := EventsByPeer{}
+			for root, creator := range nextRoots.Each() {
+				if frame.FlagTable.EventKnows(root, seenCreator, seen) {
+					knowing.AddOne(root, creator)
+				}
+			}
+			if p.hasTrust(knowing) {
+				frame.AddClothoCandidate(seen, seenCreator)
+			} else {
+				frame.DelClothoCandidate(seen, seenCreator)
+			}
+		}
+	}
+}
+
+/*
+ * Utils:
+ */
+
+// parentsFirst orders events so that parents go before their children.
+func parentsFirst(events map[hash.Event]*Event) []hash.Event {
+	res := make([]hash.Event, 0, len(events))
+	visited := hash.Events{}
+
+	var visit func(h hash.Event)
+	visit = func(h hash.Event) {
+		if !visited.Add(h) {
+			return
+		}
+		for parent := range events[h].Parents {
+			if _, ok := events[parent]; ok {
+				visit(parent)
+			}
+		}
+		res = append(res, h)
+	}
+
+	for h := range events {
+		visit(h)
+	}
+	return res
+}
+
+// containsAny returns true if set contains any of events.
+func containsAny(set, events hash.Events) bool {
+	for e := range events {
+		if set.Contains(e) {
+			return true
+		}
+	}
+	return false
+}
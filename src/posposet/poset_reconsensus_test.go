@@ -0,0 +1,197 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+func TestPosetReconsensus(t *testing.T) {
+	nodes, nodesEvents := GenEventsByNode(5, 50, 3)
+
+	t.Run("same stakes", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p := reconsensusPoset(nodes, nodesEvents)
+		start := p.state.LastFinishedFrameN + 1
+		before := framesSnapshot(p)
+
+		p.reconsensusFromFrame(start)
+
+		assert.Equal(before, framesSnapshot(p), "nothing is changed")
+	})
+
+	t.Run("changed stakes", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p := reconsensusPoset(nodes, nodesEvents)
+		start := p.state.LastFinishedFrameN + 1
+
+		stale := 0
+		for _, n := range nodes {
+			stake := p.state.Validators[n]
+			p.state.Validators[n] = 1000
+			p.state.TotalCap = p.state.Validators.TotalStake()
+
+			for h, e := range unfinishedEvents(p, start) {
+				if !p.hasFinishedParents(e) && !p.isSameDecision(h, p.decideRoot(e, nil)) {
+					stale++
+				}
+			}
+			if stale > 0 {
+				break
+			}
+
+			p.state.Validators[n] = stake
+			p.state.TotalCap = p.state.Validators.TotalStake()
+		}
+		if stale < 1 {
+			t.Skip("stake change does not affect root decisions")
+		}
+
+		p.reconsensusFromFrame(start)
+
+		for h, e := range unfinishedEvents(p, p.state.LastFinishedFrameN+1) {
+			if p.hasFinishedParents(e) {
+				continue
+			}
+			if !assert.True(p.isSameDecision(h, p.decideRoot(e, nil)), "event %s", h.String()) {
+				return
+			}
+		}
+
+		cc := framesSnapshot(p)
+		p.recheckClothoCandidates(p.state.LastFinishedFrameN+1, p.frameNumLast())
+		assert.Equal(cc, framesSnapshot(p), "clotho candidates are actual")
+	})
+}
+
+/*
+ * bench:
+ */
+
+func BenchmarkReconsensus(b *testing.B) {
+	nodes, nodesEvents := GenEventsByNode(5, 200, 3)
+
+	b.Run("full replay", func(b *testing.B) {
+		benchmarkReconsensus(b, nodes, nodesEvents, (*Poset).replayFromFrame)
+	})
+
+	b.Run("incremental", func(b *testing.B) {
+		benchmarkReconsensus(b, nodes, nodesEvents, (*Poset).reconsensusFromFrame)
+	})
+}
+
+func BenchmarkBalanceChange(b *testing.B) {
+	nodes, nodesEvents := GenEventsByNode(5, 200, 3)
+
+	b.Run("full replay", func(b *testing.B) {
+		benchmarkBalanceChange(b, nodes, nodesEvents, (*Poset).replayFromFrame)
+	})
+
+	b.Run("incremental", func(b *testing.B) {
+		benchmarkBalanceChange(b, nodes, nodesEvents, (*Poset).reconsensusFromFrame)
+	})
+}
+
+// benchmarkBalanceChange measures recalc of unfinished frames after balance change.
+func benchmarkBalanceChange(b *testing.B, nodes []hash.Peer, nodesEvents map[hash.Peer][]*Event, recalc func(*Poset, uint64)) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		p := reconsensusPoset(nodes, nodesEvents)
+		start := p.state.LastFinishedFrameN + 1
+		b.StartTimer()
+
+		if p.frame(start, true).SetBalances(hash.FakeHash()) {
+			recalc(p, start)
+		}
+	}
+}
+
+// benchmarkReconsensus measures recalc of unfinished frames after balance and stakes change.
+func benchmarkReconsensus(b *testing.B, nodes []hash.Peer, nodesEvents map[hash.Peer][]*Event, recalc func(*Poset, uint64)) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		p := reconsensusPoset(nodes, nodesEvents)
+		start := p.state.LastFinishedFrameN + 1
+		p.frame(start, true).SetBalances(hash.FakeHash())
+		p.state.Validators[nodes[0]] *= 2
+		p.state.TotalCap = p.state.Validators.TotalStake()
+		b.StartTimer()
+
+		recalc(p, start)
+	}
+}
+
+/*
+ * Poset's test methods:
+ */
+
+// replayFromFrame is a former full recalc of frames consensus, for comparison.
+func (p *Poset) replayFromFrame(start uint64) {
+	stop := p.frameNumLast()
+	var all inter.Events
+	// foreach stale frame
+	for n := start; n <= stop; n++ {
+		frame := p.frames[n]
+		// extract events
+		for e := range frame.FlagTable {
+			if !frame.FlagTable.IsRoot(e) {
+				all = append(all, p.GetEvent(e).Event)
+			}
+		}
+		// and replace stale frame with blank
+		p.frames[n] = &Frame{
+			Index:            n,
+			FlagTable:        FlagTable{},
+			ClothoCandidates: EventsByPeer{},
+			Atroposes:        TimestampsByEvent{},
+			Balances:         frame.Balances,
+		}
+	}
+	// recalc consensus
+	for _, e := range all.ByParents() {
+		p.consensus(e)
+	}
+	// foreach fresh frame
+	for n := start; n <= stop; n++ {
+		frame := p.frames[n]
+		// save fresh frame
+		p.setFrameSaving(frame)
+		frame.Save()
+	}
+}
+
+/*
+ * Utils:
+ */
+
+func reconsensusPoset(nodes []hash.Peer, nodesEvents map[hash.Peer][]*Event) *Poset {
+	p, _, input := FakePoset(nodes)
+	pushEvents(p, input, nodes, nodesEvents)
+	return p
+}
+
+func unfinishedEvents(p *Poset, start uint64) map[hash.Event]*Event {
+	res := make(map[hash.Event]*Event)
+	for n := start; n <= p.frameNumLast(); n++ {
+		if frame := p.frames[n]; frame != nil {
+			for e := range frame.FlagTable {
+				res[e] = p.GetEvent(e)
+			}
+		}
+	}
+	return res
+}
+
+// framesSnapshot returns deep copy of frames.
+func framesSnapshot(p *Poset) map[uint64]*Frame {
+	res := make(map[uint64]*Frame, len(p.frames))
+	for n, f := range p.frames {
+		res[n] = WireToFrame(f.ToWire())
+	}
+	return res
+}
@@ -1,7 +1,9 @@
 package posposet
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -78,12 +80,55 @@ func TestPoset(t *testing.T) {
 		}
 	})
 
+	t.Run("Known roots", func(t *testing.T) {
+		assert := assert.New(t)
+		for _, events := range nodesEvents {
+			for _, e := range events {
+				frame, creators := posets[0].KnownRoots(e.Hash())
+				if !assert.Equal(*posets[0].store.GetEventFrame(e.Hash()), frame) {
+					return
+				}
+				if !assert.NotEmpty(creators, "event knows roots") {
+					return
+				}
+			}
+		}
+	})
+
 	t.Run("Multiple stop", func(t *testing.T) {
 		posets[0].Stop()
 		posets[0].Stop()
 	})
 }
 
+func TestPosetBackpressure(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	p, _, _ := FakePoset(nodes)
+
+	assert.Equal(0.0, p.Backpressure())
+
+	size := cap(p.newEventsCh)
+	for i := 0; i < size/2; i++ {
+		p.PushEvent(hash.FakeEvent())
+	}
+	assert.Equal(0.5, p.Backpressure())
+
+	for i := size / 2; i < size; i++ {
+		p.PushEvent(hash.FakeEvent())
+	}
+	assert.Equal(1.0, p.Backpressure())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(context.DeadlineExceeded, p.TryPushEvent(ctx, hash.FakeEvent()), "queue is full")
+
+	<-p.newEventsCh
+	assert.NoError(p.TryPushEvent(ctx, hash.FakeEvent()))
+	assert.Equal(1.0, p.Backpressure())
+}
+
 /*
  * Poset's test methods:
  */
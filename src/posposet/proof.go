@@ -0,0 +1,129 @@
+package posposet
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+	"github.com/Fantom-foundation/go-lachesis/src/trie"
+)
+
+type (
+	// EventProof proves event is at position of block.
+	EventProof struct {
+		Block    uint64
+		Position uint64
+		Nodes    [][]byte // trie nodes from events root
+	}
+
+	// AccountProof proves account state at block.
+	AccountProof struct {
+		Block uint64
+		Nodes [][]byte // trie nodes from state root
+	}
+
+	// proofList collects trie nodes.
+	proofList [][]byte
+)
+
+// ProveEvent makes proof of event is in block.
+func (p *Poset) ProveEvent(n uint64, e hash.Event) (*EventProof, error) {
+	b := p.store.GetBlock(n)
+	if b == nil {
+		return nil, fmt.Errorf("block %d not found", n)
+	}
+
+	for i, h := range b.Events {
+		if h != e {
+			continue
+		}
+
+		var nodes proofList
+		pos := uint64(i)
+		if err := eventsTrie(b.Events).Prove(intToBytes(pos), 0, &nodes); err != nil {
+			return nil, err
+		}
+
+		return &EventProof{
+			Block:    n,
+			Position: pos,
+			Nodes:    nodes,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("event %s is not in block %d", e.String(), n)
+}
+
+// ProveAccount makes proof of account state at block.
+func (p *Poset) ProveAccount(n uint64, addr hash.Peer) (*AccountProof, error) {
+	b := p.store.GetBlock(n)
+	if b == nil {
+		return nil, fmt.Errorf("block %d not found", n)
+	}
+
+	nodes, err := p.store.StateDB(b.Root).GetProof(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountProof{
+		Block: n,
+		Nodes: nodes,
+	}, nil
+}
+
+// Verify checks proof against events root of block.
+func (p *EventProof) Verify(eventsRoot hash.Hash, e hash.Event) error {
+	val, err := verifyProof(eventsRoot, intToBytes(p.Position), p.Nodes)
+	if err != nil {
+		return err
+	}
+	if hash.BytesToEventHash(val) != e {
+		return fmt.Errorf("event %s is not at position %d", e.String(), p.Position)
+	}
+	return nil
+}
+
+// Verify checks proof against state root of block and returns account state.
+// It returns nil if account does not exist.
+func (p *AccountProof) Verify(root hash.Hash, addr hash.Peer) (*state.Account, error) {
+	val, err := verifyProof(root, crypto.Keccak256(addr.Bytes()), p.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	acc := &state.Account{}
+	if err := proto.Unmarshal(val, acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// Put implements kvdb.Putter interface.
+func (n *proofList) Put(key []byte, value []byte) error {
+	*n = append(*n, value)
+	return nil
+}
+
+/*
+ * Utils:
+ */
+
+func verifyProof(root hash.Hash, key []byte, nodes [][]byte) ([]byte, error) {
+	db := kvdb.NewMemDatabase()
+	for _, n := range nodes {
+		if err := db.Put(crypto.Keccak256(n), n); err != nil {
+			return nil, err
+		}
+	}
+
+	val, _, err := trie.VerifyProof(root, key, db)
+	return val, err
+}
@@ -0,0 +1,76 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestPosetProofs(t *testing.T) {
+	nodes, nodesEvents := GenEventsByNode(5, 50, 3)
+	p, _, input := FakePoset(nodes)
+	pushEvents(p, input, nodes, nodesEvents)
+
+	if !assert.NotZero(t, p.state.LastBlockN, "blocks are decided") {
+		return
+	}
+
+	t.Run("header chain", func(t *testing.T) {
+		assert := assert.New(t)
+
+		prev := p.GetGenesisHash()
+		for n := uint64(1); n <= p.state.LastBlockN; n++ {
+			b := p.GetBlock(n)
+			assert.Equal(prev, b.Prev, "block %d", n)
+			prev = b.Header().Hash()
+		}
+	})
+
+	t.Run("event in block", func(t *testing.T) {
+		assert := assert.New(t)
+
+		for n := uint64(1); n <= p.state.LastBlockN; n++ {
+			b := p.GetBlock(n)
+			for _, e := range b.Events {
+				proof, err := p.ProveEvent(n, e)
+				if !assert.NoError(err) {
+					return
+				}
+				assert.NoError(proof.Verify(b.EventsRoot, e))
+				assert.Error(proof.Verify(b.EventsRoot, hash.FakeEvent()), "other event")
+				assert.Error(proof.Verify(hash.FakeHash(), e), "other root")
+			}
+		}
+
+		_, err := p.ProveEvent(1, hash.FakeEvent())
+		assert.Error(err, "unknown event")
+	})
+
+	t.Run("account balance", func(t *testing.T) {
+		assert := assert.New(t)
+
+		b := p.GetBlock(p.state.LastBlockN)
+		for _, addr := range nodes {
+			proof, err := p.ProveAccount(b.Index, addr)
+			if !assert.NoError(err) {
+				return
+			}
+			acc, err := proof.Verify(b.Root, addr)
+			if !assert.NoError(err) || !assert.NotNil(acc) {
+				return
+			}
+			assert.Equal(p.store.StateDB(b.Root).FreeBalance(addr), acc.Balance)
+		}
+
+		unknown := hash.FakePeer()
+		proof, err := p.ProveAccount(b.Index, unknown)
+		if !assert.NoError(err) {
+			return
+		}
+		acc, err := proof.Verify(b.Root, unknown)
+		assert.NoError(err)
+		assert.Nil(acc, "unknown account")
+	})
+}
@@ -2,21 +2,25 @@ package posposet
 
 import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
-	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 // stakeCounter is for PoS balances accumulator.
+// It counts stakes of epoch validators only, cheaters are excluded.
 type stakeCounter struct {
-	balances *state.DB
-	amount   uint64
-	goal     uint64
+	validators Validators
+	cheaters   Cheaters
+	amount     uint64
+	goal       uint64
 }
 
 func (s *stakeCounter) Count(node hash.Peer) {
 	if s.IsGoalAchieved() {
 		return // no sense to count further
 	}
-	s.amount += s.balances.VoteBalance(node)
+	if s.cheaters.Contains(node) {
+		return
+	}
+	s.amount += s.validators[node]
 }
 
 func (s *stakeCounter) IsGoalAchieved() bool {
@@ -34,28 +38,25 @@ func (p *Poset) StakeOf(addr hash.Peer) uint64 {
 	return db.VoteBalance(addr)
 }
 
-func (p *Poset) newStakeCounter(frame *Frame, goal uint64) *stakeCounter {
-	db := p.store.StateDB(frame.Balances)
-
+func (p *Poset) newStakeCounter(goal uint64) *stakeCounter {
 	return &stakeCounter{
-		balances: db,
-		amount:   0,
-		goal:     goal,
+		validators: p.state.Validators,
+		cheaters:   p.state.Cheaters,
+		amount:     0,
+		goal:       goal,
 	}
 }
 
-func (p *Poset) hasMajority(frame *Frame, roots EventsByPeer) bool {
-	stake := p.newStakeCounter(frame,
-		p.state.TotalCap*2/3)
+func (p *Poset) hasMajority(roots EventsByPeer) bool {
+	stake := p.newStakeCounter(p.params.Majority.Of(p.state.TotalCap))
 	for node := range roots {
 		stake.Count(node)
 	}
 	return stake.IsGoalAchieved()
 }
 
-func (p *Poset) hasTrust(frame *Frame, roots EventsByPeer) bool {
-	stake := p.newStakeCounter(frame,
-		p.state.TotalCap*1/3)
+func (p *Poset) hasTrust(roots EventsByPeer) bool {
+	stake := p.newStakeCounter(p.params.Trust.Of(p.state.TotalCap))
 	for node := range roots {
 		stake.Count(node)
 	}
@@ -4,6 +4,7 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/logger"
 	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 // TODO: make State internal
@@ -14,6 +15,10 @@ type State struct {
 	LastBlockN         uint64
 	Genesis            hash.Hash
 	TotalCap           uint64
+	Epoch              uint64
+	Validators         Validators
+	Cheaters           Cheaters
+	NewCheaters        Cheaters
 }
 
 // ToWire converts to proto.Message.
@@ -23,6 +28,10 @@ func (s *State) ToWire() *wire.State {
 		LastBlockN:         s.LastBlockN,
 		Genesis:            s.Genesis.Bytes(),
 		TotalCap:           s.TotalCap,
+		Epoch:              s.Epoch,
+		Validators:         s.Validators.ToWire(),
+		Cheaters:           s.Cheaters.ToWire(),
+		NewCheaters:        s.NewCheaters.ToWire(),
 	}
 }
 
@@ -36,6 +45,10 @@ func WireToState(w *wire.State) *State {
 		LastBlockN:         w.LastBlockN,
 		Genesis:            hash.FromBytes(w.Genesis),
 		TotalCap:           w.TotalCap,
+		Epoch:              w.Epoch,
+		Validators:         WireToValidators(w.Validators),
+		Cheaters:           WireToCheaters(w.Cheaters),
+		NewCheaters:        WireToCheaters(w.NewCheaters),
 	}
 }
 
@@ -61,6 +74,7 @@ func (p *Poset) Bootstrap() {
 	// restore frames
 	for n := p.state.LastFinishedFrameN; true; n++ {
 		if f := p.store.GetFrame(n); f != nil {
+			p.setFrameSaving(f)
 			p.frames[n] = f
 		} else if n > 0 {
 			break
@@ -70,8 +84,21 @@ func (p *Poset) Bootstrap() {
 	p.reconsensusFromFrame(p.state.LastFinishedFrameN + 1)
 }
 
+// GetGenesisHash returns hash of genesis balances, consensus and PoS params,
+// so nodes with different params are not compatible.
 func (p *Poset) GetGenesisHash() hash.Hash {
-	return p.state.Genesis
+	return hash.Of(p.state.Genesis.Bytes(), p.params.Hash().Bytes(), p.conf.Hash().Bytes())
+}
+
+// committedState returns balances state after the last block.
+// It reads the store only, so it is safe for concurrent use.
+func (p *Poset) committedState() *state.DB {
+	st := p.store.GetState()
+	root := st.Genesis
+	if b := p.store.GetBlock(st.LastBlockN); b != nil {
+		root = b.Root
+	}
+	return p.store.StateDB(root)
 }
 
 // GenesisHash calcs hash of genesis balances.
@@ -7,8 +7,11 @@ import (
 	"github.com/hashicorp/golang-lru"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	interwire "github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/kvdb"
 	"github.com/Fantom-foundation/go-lachesis/src/logger"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
 	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
@@ -24,6 +27,8 @@ type Store struct {
 	frames      kvdb.Database
 	blocks      kvdb.Database
 	event2frame kvdb.Database
+	txns        kvdb.Database
+	forkProofs  kvdb.Database
 
 	framesCache      *lru.Cache
 	event2frameCache *lru.Cache
@@ -59,6 +64,8 @@ func (s *Store) init() {
 	s.frames = kvdb.NewTable(s.physicalDB, "frame_")
 	s.blocks = kvdb.NewTable(s.physicalDB, "block_")
 	s.event2frame = kvdb.NewTable(s.physicalDB, "event2frame_")
+	s.txns = kvdb.NewTable(s.physicalDB, "transaction_")
+	s.forkProofs = kvdb.NewTable(s.physicalDB, "fork_proof_")
 
 	s.balances = state.NewDatabase(
 		kvdb.NewTable(s.physicalDB, "balance_"))
@@ -79,6 +86,8 @@ func (s *Store) initCache() {
 
 // Close leaves underlying database.
 func (s *Store) Close() {
+	s.forkProofs = nil
+	s.txns = nil
 	s.event2frame = nil
 	s.balances = nil
 	s.frames = nil
@@ -106,17 +115,22 @@ func (s *Store) ApplyGenesis(balances map[hash.Peer]uint64) error {
 	st = &State{
 		LastFinishedFrameN: 0,
 		TotalCap:           0,
+		Validators:         Validators{},
+		Cheaters:           Cheaters{},
+		NewCheaters:        Cheaters{},
 	}
 
 	genesis := s.StateDB(hash.Hash{})
 	for addr, balance := range balances {
+		if balance == 0 {
+			return fmt.Errorf("balance shouldn't be zero")
+		}
 		genesis.SetBalance(hash.Peer(addr), balance)
-		st.TotalCap += balance
-	}
-
-	if st.TotalCap < uint64(len(balances)) {
-		return fmt.Errorf("balance shouldn't be zero")
+		if addr != pos.SPV {
+			st.Validators[addr] = balance
+		}
 	}
+	st.TotalCap = st.Validators.TotalStake()
 
 	var err error
 	st.Genesis, err = genesis.Commit(true)
@@ -215,6 +229,30 @@ func (s *Store) GetBlock(n uint64) *Block {
 	return WireToBlock(w)
 }
 
+// SetTransaction stores internal transaction info.
+func (s *Store) SetTransaction(h hash.Transaction, info *TransactionInfo) {
+	s.set(s.txns, h.Bytes(), info.ToWire())
+}
+
+// GetTransaction returns stored internal transaction info.
+// Transactions are seldom read; so no cache.
+func (s *Store) GetTransaction(h hash.Transaction) *TransactionInfo {
+	w, _ := s.get(s.txns, h.Bytes(), &wire.TransactionInfo{}).(*wire.TransactionInfo)
+	return WireToTransactionInfo(w)
+}
+
+// SetForkProof stores cheating evidence of creator.
+func (s *Store) SetForkProof(proof *inter.ForkProof) {
+	key := proof.Creator()
+	s.set(s.forkProofs, key.Bytes(), proof.ToWire())
+}
+
+// GetForkProof returns stored cheating evidence of creator.
+func (s *Store) GetForkProof(creator hash.Peer) *inter.ForkProof {
+	w, _ := s.get(s.forkProofs, creator.Bytes(), &interwire.ForkProof{}).(*interwire.ForkProof)
+	return inter.WireToForkProof(w)
+}
+
 // StateDB returns state database.
 func (s *Store) StateDB(from hash.Hash) *state.DB {
 	db, err := state.New(from, s.balances)
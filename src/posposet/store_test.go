@@ -27,6 +27,29 @@ func Test_IntToBytes(t *testing.T) {
 	}
 }
 
+func TestStoreTransactions(t *testing.T) {
+	assert := assert.New(t)
+
+	db := kvdb.NewMemDatabase()
+	store := NewStore(db, false)
+
+	h := hash.FakeTransaction()
+	info := &TransactionInfo{
+		Event:    hash.FakeEvent(),
+		Position: 2,
+		Block:    3,
+		Frame:    4,
+		Applied:  true,
+	}
+	store.SetTransaction(h, info)
+
+	// restart
+	store = NewStore(db, false)
+
+	assert.Equal(info, store.GetTransaction(h))
+	assert.Nil(store.GetTransaction(hash.FakeTransaction()))
+}
+
 /*
  * bench:
  */
@@ -84,7 +107,7 @@ func benchPoset(nodes []hash.Peer, input EventSource, store *Store, cached bool)
 		panic(err)
 	}
 
-	poset := New(store, input)
+	poset := New(store, input, nil, nil)
 	poset.Bootstrap()
 
 	return poset
@@ -0,0 +1,217 @@
+package posposet
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+)
+
+// NotificationKind is a kind of finality notification.
+// Kinds are bit flags to subscribe to several of them.
+type NotificationKind uint8
+
+const (
+	// BlockDecided notifies about new block.
+	BlockDecided NotificationKind = 1 << iota
+	// AtroposDecided notifies about Atropos of frame.
+	AtroposDecided
+	// EventConfirmed notifies about event confirmed in block.
+	EventConfirmed
+
+	// AllNotifications is a set of all the kinds.
+	AllNotifications = BlockDecided | AtroposDecided | EventConfirmed
+)
+
+// SlowPolicy is a behaviour on subscriber's buffer is full.
+type SlowPolicy uint8
+
+const (
+	// DropSlow skips notifications the subscriber has no room for.
+	DropSlow SlowPolicy = iota
+	// BlockOnSlow waits for the subscriber, so consensus waits too.
+	BlockOnSlow
+	// DisconnectSlow unsubscribes the subscriber and closes its channel.
+	DisconnectSlow
+)
+
+type (
+	// Notification is a finality event.
+	Notification struct {
+		Kind  NotificationKind
+		Block uint64
+		Frame uint64
+		Event hash.Event      // Atropos or confirmed event, empty for BlockDecided
+		Time  inter.Timestamp // consensus time of Atropos
+	}
+
+	// Subscription is a notifications receiver.
+	// C is closed on unsubscribe.
+	Subscription struct {
+		C <-chan Notification
+
+		ch      chan Notification
+		kinds   NotificationKind
+		policy  SlowPolicy
+		dropped uint64
+		done    chan struct{}
+		once    sync.Once
+		poset   *Poset
+
+		closed bool
+		sendMu sync.Mutex // guards ch from send after close
+	}
+)
+
+// Subscribe makes subscription to notifications of kinds.
+// Buffer is a count of notifications to hold for subscriber,
+// policy is what to do when buffer is full.
+func (p *Poset) Subscribe(kinds NotificationKind, buffer int, policy SlowPolicy) *Subscription {
+	ch := make(chan Notification, buffer)
+	s := &Subscription{
+		C:      ch,
+		ch:     ch,
+		kinds:  kinds,
+		policy: policy,
+		done:   make(chan struct{}),
+		poset:  p,
+	}
+
+	p.subscribersMu.Lock()
+	defer p.subscribersMu.Unlock()
+
+	if p.subscribers == nil {
+		p.subscribers = make(map[*Subscription]struct{})
+	}
+	p.subscribers[s] = struct{}{}
+
+	return s
+}
+
+// Unsubscribe stops notifications and closes C.
+// It is safe to call it several times.
+func (s *Subscription) Unsubscribe() {
+	// release blocked notify() first
+	s.once.Do(func() {
+		close(s.done)
+	})
+
+	s.poset.subscribersMu.Lock()
+	defer s.poset.subscribersMu.Unlock()
+
+	s.poset.unsubscribe(s)
+}
+
+// Dropped returns count of notifications skipped by DropSlow policy.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// unsubscribeAll closes all the subscriptions.
+func (p *Poset) unsubscribeAll() {
+	p.subscribersMu.Lock()
+	defer p.subscribersMu.Unlock()
+
+	for s := range p.subscribers {
+		p.unsubscribe(s)
+	}
+}
+
+// unsubscribe should be called under subscribersMu lock.
+func (p *Poset) unsubscribe(s *Subscription) {
+	if _, ok := p.subscribers[s]; !ok {
+		return
+	}
+	delete(p.subscribers, s)
+
+	s.once.Do(func() {
+		close(s.done)
+	})
+
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	s.closed = true
+	close(s.ch)
+}
+
+// notifyBlock notifies subscribers about new block, its Atroposes and events.
+// It should be called after block transactions are applied.
+func (p *Poset) notifyBlock(block *Block, frameN uint64) {
+	frame := p.frame(frameN, false)
+
+	p.notify(Notification{
+		Kind:  BlockDecided,
+		Block: block.Index,
+		Frame: frameN,
+	})
+
+	for _, e := range block.Events {
+		if t, ok := frame.Atroposes[e]; ok {
+			p.notify(Notification{
+				Kind:  AtroposDecided,
+				Block: block.Index,
+				Frame: frameN,
+				Event: e,
+				Time:  t,
+			})
+		}
+		p.notify(Notification{
+			Kind:  EventConfirmed,
+			Block: block.Index,
+			Frame: frameN,
+			Event: e,
+		})
+	}
+}
+
+// notify sends notification to subscribers according to their policies.
+// Subscribers are delivered without lock, so blocked one does not block (un)subscribing.
+func (p *Poset) notify(n Notification) {
+	p.subscribersMu.Lock()
+	subscribers := make([]*Subscription, 0, len(p.subscribers))
+	for s := range p.subscribers {
+		if s.kinds&n.Kind != 0 {
+			subscribers = append(subscribers, s)
+		}
+	}
+	p.subscribersMu.Unlock()
+
+	for _, s := range subscribers {
+		if !s.send(n) {
+			p.Warnf("Slow subscriber is disconnected")
+			s.Unsubscribe()
+		}
+	}
+}
+
+// send delivers notification according to policy.
+// Returns false if slow subscriber should be disconnected.
+func (s *Subscription) send(n Notification) bool {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	if s.closed {
+		return true
+	}
+
+	select {
+	case s.ch <- n:
+		return true
+	default:
+	}
+
+	switch s.policy {
+	case DropSlow:
+		atomic.AddUint64(&s.dropped, 1)
+	case BlockOnSlow:
+		select {
+		case s.ch <- n:
+		case <-s.done:
+		}
+	case DisconnectSlow:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+func TestPosetSubscription(t *testing.T) {
+	nodes, nodesEvents := GenEventsByNode(5, 50, 3)
+
+	t.Run("all notifications", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, _, input := FakePoset(nodes)
+		sub := p.Subscribe(AllNotifications, 10000, BlockOnSlow)
+		pushEvents(p, input, nodes, nodesEvents)
+		p.unsubscribeAll()
+
+		var (
+			blocks    uint64
+			atroposes int
+			confirmed = hash.Events{}
+		)
+		for n := range sub.C {
+			switch n.Kind {
+			case BlockDecided:
+				blocks++
+				assert.Equal(blocks, n.Block)
+			case AtroposDecided:
+				atroposes++
+				assert.NotZero(n.Time)
+			case EventConfirmed:
+				confirmed.Add(n.Event)
+			}
+		}
+
+		if !assert.NotZero(blocks, "blocks are decided") {
+			return
+		}
+		assert.Equal(p.state.LastBlockN, blocks)
+		assert.NotZero(atroposes)
+		for n := uint64(1); n <= blocks; n++ {
+			for _, e := range p.store.GetBlock(n).Events {
+				assert.True(confirmed.Contains(e), "event %s of block %d", e.String(), n)
+			}
+		}
+	})
+
+	t.Run("drop slow", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, _, input := FakePoset(nodes)
+		sub := p.Subscribe(EventConfirmed, 1, DropSlow)
+		pushEvents(p, input, nodes, nodesEvents)
+
+		assert.NotZero(sub.Dropped())
+		assert.Len(sub.C, 1)
+		assert.Contains(p.subscribers, sub)
+	})
+
+	t.Run("disconnect slow", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, _, input := FakePoset(nodes)
+		sub := p.Subscribe(EventConfirmed, 1, DisconnectSlow)
+		pushEvents(p, input, nodes, nodesEvents)
+
+		assert.NotContains(p.subscribers, sub)
+		<-sub.C
+		_, ok := <-sub.C
+		assert.False(ok, "channel is closed")
+	})
+
+	t.Run("unsubscribe blocked", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, _, input := FakePoset(nodes)
+		sub := p.Subscribe(AllNotifications, 0, BlockOnSlow)
+		go func() {
+			<-sub.C
+			sub.Unsubscribe()
+		}()
+		pushEvents(p, input, nodes, nodesEvents)
+		sub.Unsubscribe()
+
+		assert.NotZero(p.state.LastBlockN, "consensus is not blocked")
+	})
+
+	t.Run("subscribe while blocked", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, _, input := FakePoset(nodes)
+		slow := p.Subscribe(AllNotifications, 0, BlockOnSlow)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			pushEvents(p, input, nodes, nodesEvents)
+		}()
+		<-slow.C
+
+		// the next notification waits for slow subscriber
+		other := p.Subscribe(BlockDecided, 1, DropSlow)
+		other.Unsubscribe()
+		_, ok := <-other.C
+		assert.False(ok, "channel is closed")
+
+		slow.Unsubscribe()
+		<-done
+	})
+}
+
+/*
+ * Utils:
+ */
+
+func pushEvents(p *Poset, input *EventStore, nodes []hash.Peer, nodesEvents map[hash.Peer][]*Event) {
+	for _, n := range nodes {
+		for _, e := range nodesEvents[n] {
+			input.SetEvent(e.Event)
+			p.PushEventSync(e.Hash())
+		}
+	}
+}
@@ -9,22 +9,57 @@ and the payment of all rewards.
 */
 
 import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
-	"github.com/Fantom-foundation/go-lachesis/src/logger"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
 	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 // GetTransaction returns transaction by hash.
+// If transaction is not found returns nil.
 func (p *Poset) GetTransaction(h hash.Transaction) *inter.InternalTransaction {
-	// TODO: implement. If transaction is not found return nil.
-	return &inter.InternalTransaction{}
+	info := p.store.GetTransaction(h)
+	if info == nil {
+		return nil
+	}
+
+	e := p.input.GetEvent(info.Event)
+	if e == nil || int(info.Position) >= len(e.InternalTransactions) {
+		p.Errorf("transaction %s refers to unknown event %s", h.Hex(), info.Event.String())
+		return nil
+	}
+
+	return e.InternalTransactions[info.Position]
 }
 
-// GetTransactionCount returns transaction count for peer.
+// GetTransactionInfo returns place of transaction in consensus.
+// If transaction is not found returns nil.
+func (p *Poset) GetTransactionInfo(h hash.Transaction) *TransactionInfo {
+	return p.store.GetTransaction(h)
+}
+
+// GetTransactionCount returns the last applied transaction nonce of peer.
 func (p *Poset) GetTransactionCount(h hash.Peer) uint64 {
-	// TODO: implement.
-	return 0
+	return p.committedState().GetNonce(h)
+}
+
+// GetDelegations returns incoming and outgoing delegations of peer.
+func (p *Poset) GetDelegations(addr hash.Peer) (incoming, outgoing []*state.Delegation) {
+	dd := p.committedState().GetDelegationRecs(addr)
+	return dd[state.FROM], dd[state.TO]
+}
+
+// GetValidatorKey returns registered public key of validator from committed state.
+// If key is not registered returns nil.
+func (p *Poset) GetValidatorKey(addr hash.Peer) *common.PublicKey {
+	buf := p.committedState().GetValidatorKey(addr)
+	if buf == nil {
+		return nil
+	}
+	return common.BytesToPubkey(buf)
 }
 
 // isEventValid validates event according to frame state.
@@ -37,34 +72,254 @@ func (p *Poset) isEventValid(e *Event, f *Frame) bool {
 	return true
 }
 
-// applyTransactions execs ordered txns on state.
-// TODO: fine of invalid txns
-// TODO: transaction fees
-func applyTransactions(db *state.DB, ordered Events) {
+// applyTransactions execs ordered txns of block on state
+// and indexes them. Duplicated and out-of-order (by nonce) txns are skipped.
+// Each applied txn costs a fee, each invalid txn is fined. Both go to SPV.
+// Duplicates are not fined as they could be applied already.
+func (p *Poset) applyTransactions(db *state.DB, block uint64, ordered Events) {
 	for _, e := range ordered {
 		sender := e.Creator
-		for _, tx := range e.InternalTransactions {
-			receiver := tx.Receiver
 
-			if db.FreeBalance(sender) < tx.Amount {
-				logger.Get().Warnf("Cannot send %d from %s to %s: balance is insufficient, skipped", tx.Amount, sender.String(), receiver.String())
+		var frame uint64
+		if f := p.store.GetEventFrame(e.Hash()); f != nil {
+			frame = *f
+		}
+
+		for i, tx := range e.InternalTransactions {
+			h := inter.TransactionHashOf(sender, tx)
+
+			nonce := db.GetNonce(sender)
+			if tx.Index <= nonce {
+				p.Warnf("Cannot apply tx %s of %s: nonce %d is applied already, skipped", h.Hex(), sender.String(), tx.Index)
+				continue
+			}
+			if expect := nonce + 1; tx.Index != expect {
+				p.Warnf("Cannot apply tx %s of %s: nonce %d is not expected %d, skipped", h.Hex(), sender.String(), tx.Index, expect)
+				p.applyFine(db, sender)
 				continue
 			}
+			db.SetNonce(sender, tx.Index)
 
-			if !db.Exist(receiver) {
-				db.CreateAccount(receiver)
+			info := &TransactionInfo{
+				Event:    e.Hash(),
+				Position: uint32(i),
+				Block:    block,
+				Frame:    frame,
 			}
 
-			if tx.UntilBlock == 0 {
-				db.Transfer(sender, receiver, tx.Amount)
-			} else {
-				db.Delegate(sender, receiver, tx.Amount, tx.UntilBlock)
+			if err := p.applyTransaction(db, sender, tx, block); err != nil {
+				p.Warnf("Cannot apply tx %s of %s: %s, skipped", h.Hex(), sender.String(), err)
+				p.applyFine(db, sender)
+				p.store.SetTransaction(h, info)
+				continue
 			}
+			db.Transfer(sender, pos.SPV, p.conf.TxFee)
+
+			info.Applied = true
+			p.store.SetTransaction(h, info)
+		}
+	}
+}
+
+// txHandler execs txn of the kind on state.
+// Fee is not charged here, but sender's balance should be enough for it.
+type txHandler func(p *Poset, db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error
+
+// txHandlers dispatches txns by kind.
+var txHandlers = map[inter.InternalTransactionKind]txHandler{
+	inter.TransferTx:              (*Poset).applyTransfer,
+	inter.DelegateTx:              (*Poset).applyDelegate,
+	inter.UndelegateTx:            (*Poset).applyUndelegate,
+	inter.RedelegateTx:            (*Poset).applyRedelegate,
+	inter.SlashTx:                 (*Poset).applySlash,
+	inter.ValidatorRegistrationTx: (*Poset).applyValidatorRegistration,
+}
+
+// applyTransaction execs txn according to its kind.
+func (p *Poset) applyTransaction(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	kind := tx.ActualKind()
+	handler, ok := txHandlers[kind]
+	if !ok {
+		return fmt.Errorf("unknown kind %s", kind)
+	}
+	return handler(p, db, sender, tx, block)
+}
+
+func (p *Poset) applyTransfer(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	receiver := tx.Receiver
+	if db.FreeBalance(sender) < tx.Amount+p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to send %d to %s", tx.Amount, receiver.String())
+	}
+
+	if !db.Exist(receiver) {
+		db.CreateAccount(receiver)
+	}
+	db.Transfer(sender, receiver, tx.Amount)
+	return nil
+}
+
+func (p *Poset) applyDelegate(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	receiver := tx.Receiver
+	if db.FreeBalance(sender) < tx.Amount+p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to delegate %d to %s", tx.Amount, receiver.String())
+	}
+	if tx.UntilBlock == 0 {
+		return fmt.Errorf("delegation to %s has no expiration", receiver.String())
+	}
+
+	if !db.Exist(receiver) {
+		db.CreateAccount(receiver)
+	}
+	if !db.CanDelegate(sender, receiver, tx.Amount) {
+		return fmt.Errorf("delegation limit of %d to %s is exceeded", tx.Amount, receiver.String())
+	}
+	db.Delegate(sender, receiver, tx.Amount, tx.UntilBlock)
+	return nil
+}
+
+func (p *Poset) applyUndelegate(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	receiver := tx.Receiver
+	if db.FreeBalance(sender) < p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to undelegate from %s", receiver.String())
+	}
+	if !hasDelegation(db, sender, receiver, tx.Amount, tx.UntilBlock) {
+		return fmt.Errorf("no delegation of %d to %s until %d", tx.Amount, receiver.String(), tx.UntilBlock)
+	}
+
+	db.Undelegate(sender, receiver, tx.Amount, tx.UntilBlock)
+	return nil
+}
+
+func (p *Poset) applyRedelegate(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	source, receiver := tx.Source, tx.Receiver
+	if db.FreeBalance(sender) < p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to redelegate to %s", receiver.String())
+	}
+	if !hasDelegation(db, sender, source, tx.Amount, tx.UntilBlock) {
+		return fmt.Errorf("no delegation of %d to %s until %d", tx.Amount, source.String(), tx.UntilBlock)
+	}
+
+	revision := db.Snapshot()
+	db.Undelegate(sender, source, tx.Amount, tx.UntilBlock)
+	if !db.Exist(receiver) {
+		db.CreateAccount(receiver)
+	}
+	if !db.CanDelegate(sender, receiver, tx.Amount) {
+		db.RevertToSnapshot(revision)
+		return fmt.Errorf("delegation limit of %d to %s is exceeded", tx.Amount, receiver.String())
+	}
+	db.Delegate(sender, receiver, tx.Amount, tx.UntilBlock)
+	return nil
+}
+
+func (p *Poset) applySlash(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	offender := tx.Receiver
+	if db.FreeBalance(sender) < p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to slash %s", offender.String())
+	}
+	proof := p.store.GetForkProof(offender)
+	if proof == nil {
+		return fmt.Errorf("no fork proof of %s", offender.String())
+	}
+	forkIndex := proof.First.Index
+	if isSlashed(db, offender, forkIndex) {
+		return fmt.Errorf("%s is slashed already for fork at %d", offender.String(), forkIndex)
+	}
+
+	amount := db.Slash(offender, pos.SPV, p.conf.SlashPercent, block, forkIndex)
+	p.Infof("%s is slashed for %d at block %d", offender.String(), amount, block)
+	return nil
+}
+
+func (p *Poset) applyValidatorRegistration(db *state.DB, sender hash.Peer, tx *inter.InternalTransaction, block uint64) error {
+	if db.FreeBalance(sender) < p.conf.TxFee {
+		return fmt.Errorf("balance is insufficient to register validator key")
+	}
+	if len(tx.PubKey) == 0 || hash.PeerOfPubkeyBytes(tx.PubKey) != sender {
+		return fmt.Errorf("public key does not belong to %s", sender.String())
+	}
+	if key := common.BytesToPubkey(tx.PubKey); key.X == nil {
+		return fmt.Errorf("public key is invalid")
+	}
+
+	db.SetValidatorKey(sender, tx.PubKey)
+	return nil
+}
+
+// applyFine charges creator of invalid txn for SPV.
+func (p *Poset) applyFine(db *state.DB, creator hash.Peer) {
+	fine := p.conf.InvalidTxFine
+	if free := db.FreeBalance(creator); free < fine {
+		fine = free
+	}
+	if fine > 0 {
+		db.Transfer(creator, pos.SPV, fine)
+	}
+}
+
+// applyRewards pays block reward from SPV to the creators of block events
+// in proportion to their stakes. Reward is limited by SPV balance,
+// as no tokens are issued: it redistributes collected fees and fines only.
+func (p *Poset) applyRewards(db *state.DB, ordered Events) {
+	reward := p.conf.BlockReward
+	if free := db.FreeBalance(pos.SPV); free < reward {
+		reward = free
+	}
+	if reward == 0 {
+		return
+	}
+
+	var (
+		creators []hash.Peer
+		stakes   = make(map[hash.Peer]uint64)
+		total    uint64
+	)
+	for _, e := range ordered {
+		if _, ok := stakes[e.Creator]; ok {
+			continue
 		}
+		stake := db.VoteBalance(e.Creator)
+		creators = append(creators, e.Creator)
+		stakes[e.Creator] = stake
+		total += stake
+	}
+	if total == 0 {
+		return
 	}
+
+	for _, creator := range creators {
+		// NOTE: remainder of integer division stays at SPV
+		part := reward * stakes[creator] / total
+		if part > 0 {
+			db.Transfer(pos.SPV, creator, part)
+		}
+	}
+}
+
+/*
+ * Utils:
+ */
+
+// isSlashed returns true if account has been slashed as offender
+// for the fork of its event with index.
+func isSlashed(db *state.DB, addr hash.Peer, forkIndex uint64) bool {
+	for _, rec := range db.GetSlashes(addr) {
+		if rec.Offender == addr.Hex() && rec.ForkIndex == forkIndex {
+			return true
+		}
+	}
+	return false
 }
 
-// applyRewards calcs block rewards.
-func applyRewards(db *state.DB, ordered Events) {
-	// TODO: implement it
+// hasDelegation returns true if delegation record until block covers amount.
+func hasDelegation(db *state.DB, from, to hash.Peer, amount, until uint64) bool {
+	if amount == 0 {
+		return false
+	}
+	for _, rec := range db.GetDelegationRecs(from)[state.TO] {
+		if rec.Peer == to && rec.Until == until {
+			return rec.Amount >= amount
+		}
+	}
+	return false
 }
@@ -0,0 +1,40 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet/wire"
+)
+
+// TransactionInfo is a place of internal transaction in consensus.
+type TransactionInfo struct {
+	Event    hash.Event
+	Position uint32
+	Block    uint64
+	Frame    uint64
+	Applied  bool
+}
+
+// ToWire converts to proto.Message.
+func (t *TransactionInfo) ToWire() *wire.TransactionInfo {
+	return &wire.TransactionInfo{
+		Event:    t.Event.Bytes(),
+		Position: t.Position,
+		Block:    t.Block,
+		Frame:    t.Frame,
+		Applied:  t.Applied,
+	}
+}
+
+// WireToTransactionInfo converts from wire.
+func WireToTransactionInfo(w *wire.TransactionInfo) *TransactionInfo {
+	if w == nil {
+		return nil
+	}
+	return &TransactionInfo{
+		Event:    hash.BytesToEventHash(w.Event),
+		Position: w.Position,
+		Block:    w.Block,
+		Frame:    w.Frame,
+		Applied:  w.Applied,
+	}
+}
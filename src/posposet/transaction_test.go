@@ -0,0 +1,387 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+)
+
+func TestPosetTransactions(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+	p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+		nodes[0]: 10,
+		nodes[1]: 10,
+	}, &pos.Config{
+		TxFee:         1,
+		InvalidTxFine: 2,
+	})
+
+	tx0 := &inter.InternalTransaction{
+		Index:    1,
+		Amount:   5,
+		Receiver: nodes[1],
+	}
+	tx1 := &inter.InternalTransaction{
+		Index:    2,
+		Amount:   4,
+		Receiver: nodes[1],
+	}
+	e := &inter.Event{
+		Index:                1,
+		Creator:              nodes[0],
+		Parents:              hash.NewEvents(hash.ZeroEvent),
+		InternalTransactions: []*inter.InternalTransaction{tx0, tx1},
+	}
+	input.SetEvent(e)
+	store.SetEventFrame(e.Hash(), 1)
+
+	db := store.StateDB(p.state.Genesis)
+	p.applyTransactions(db, 1, Events{&Event{Event: e}})
+
+	h0, h1 := inter.TransactionHashOf(nodes[0], tx0), inter.TransactionHashOf(nodes[0], tx1)
+
+	assert.Equal(tx0, p.GetTransaction(h0))
+	assert.Equal(tx1, p.GetTransaction(h1))
+	assert.Nil(p.GetTransaction(hash.FakeTransaction()))
+
+	assert.Equal(&TransactionInfo{
+		Event:    e.Hash(),
+		Position: 0,
+		Block:    1,
+		Frame:    1,
+		Applied:  true,
+	}, p.GetTransactionInfo(h0))
+	assert.Equal(&TransactionInfo{
+		Event:    e.Hash(),
+		Position: 1,
+		Block:    1,
+		Frame:    1,
+		Applied:  false,
+	}, p.GetTransactionInfo(h1), "balance is insufficient")
+
+	assert.Equal(uint64(2), db.GetNonce(nodes[0]))
+	assert.Equal(uint64(0), db.GetNonce(nodes[1]))
+
+	// amount and fee are paid, fine is charged for invalid tx1
+	assert.Equal(uint64(10-5-1-2), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(10+5), db.FreeBalance(nodes[1]))
+	assert.Equal(uint64(1+2), db.FreeBalance(pos.SPV))
+
+	// duplicates are skipped without fine
+	e = &inter.Event{
+		Index:                2,
+		Creator:              nodes[0],
+		Parents:              hash.NewEvents(e.Hash()),
+		InternalTransactions: []*inter.InternalTransaction{tx0},
+	}
+	input.SetEvent(e)
+	p.applyTransactions(db, 2, Events{&Event{Event: e}})
+
+	assert.Equal(uint64(2), db.GetNonce(nodes[0]))
+	assert.Equal(uint64(1), p.GetTransactionInfo(h0).Block)
+	assert.Equal(uint64(10-5-1-2), db.FreeBalance(nodes[0]))
+
+	// out-of-order nonces are skipped and fined
+	tx2 := &inter.InternalTransaction{
+		Index:    4,
+		Amount:   1,
+		Receiver: nodes[0],
+	}
+	e = &inter.Event{
+		Index:                3,
+		Creator:              nodes[0],
+		Parents:              hash.NewEvents(e.Hash()),
+		InternalTransactions: []*inter.InternalTransaction{tx2},
+	}
+	input.SetEvent(e)
+	p.applyTransactions(db, 3, Events{&Event{Event: e}})
+
+	assert.Equal(uint64(2), db.GetNonce(nodes[0]))
+	assert.Nil(p.GetTransaction(inter.TransactionHashOf(nodes[0], tx2)))
+
+	// fine is limited by balance
+	assert.Equal(uint64(0), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(1+2+2), db.FreeBalance(pos.SPV))
+
+	// nonce is a part of committed state
+	root, err := db.Commit(true)
+	if !assert.NoError(err) {
+		return
+	}
+	store.SetBlock(&Block{Index: 1, Root: root})
+	p.state.LastBlockN = 1
+	p.saveState()
+
+	assert.Equal(uint64(2), p.GetTransactionCount(nodes[0]))
+	assert.Equal(uint64(0), p.GetTransactionCount(nodes[1]))
+}
+
+func TestPosetRewards(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	p, store, _ := fakePosetWithBalances(map[hash.Peer]uint64{
+		nodes[0]: 10,
+		nodes[1]: 30,
+		nodes[2]: 60,
+		pos.SPV:  9,
+	}, &pos.Config{
+		BlockReward: 8,
+	})
+
+	block := Events{
+		&Event{Event: &inter.Event{Creator: nodes[0]}},
+		&Event{Event: &inter.Event{Creator: nodes[1]}},
+		&Event{Event: &inter.Event{Creator: nodes[0]}},
+	}
+
+	db := store.StateDB(p.state.Genesis)
+	p.applyRewards(db, block)
+
+	assert.Equal(uint64(10+2), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(30+6), db.FreeBalance(nodes[1]))
+	assert.Equal(uint64(60), db.FreeBalance(nodes[2]))
+	assert.Equal(uint64(9-8), db.FreeBalance(pos.SPV))
+
+	// reward is limited by SPV balance
+	p.applyRewards(db, block)
+
+	assert.Equal(uint64(12), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(36), db.FreeBalance(nodes[1]))
+	assert.Equal(uint64(1), db.FreeBalance(pos.SPV))
+}
+
+func TestPosetSlashing(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+		nodes[0]: 10,
+		nodes[1]: 100,
+		nodes[2]: 100,
+	}, &pos.Config{
+		TxFee:         1,
+		InvalidTxFine: 2,
+		SlashPercent:  10,
+	})
+
+	db := store.StateDB(p.state.Genesis)
+	db.Delegate(nodes[2], nodes[1], 50, 100)
+
+	slash := func(index uint64) *inter.InternalTransaction {
+		tx := &inter.InternalTransaction{
+			Index:    index,
+			Receiver: nodes[1],
+			Kind:     inter.SlashTx,
+		}
+		e := &inter.Event{
+			Index:                index,
+			Creator:              nodes[0],
+			Parents:              hash.NewEvents(hash.ZeroEvent),
+			InternalTransactions: []*inter.InternalTransaction{tx},
+		}
+		input.SetEvent(e)
+		p.applyTransactions(db, index, Events{&Event{Event: e}})
+		return tx
+	}
+
+	// no proof
+	tx := slash(1)
+	assert.False(p.GetTransactionInfo(inter.TransactionHashOf(nodes[0], tx)).Applied)
+	assert.Equal(uint64(100+50), db.VoteBalance(nodes[1]))
+	assert.Equal(uint64(10-2), db.FreeBalance(nodes[0]))
+
+	store.SetForkProof(inter.NewForkProof(
+		&inter.Event{Index: 1, Creator: nodes[1]},
+		&inter.Event{Index: 1, Creator: nodes[1], LamportTime: 1},
+	))
+
+	tx = slash(2)
+	assert.True(p.GetTransactionInfo(inter.TransactionHashOf(nodes[0], tx)).Applied)
+	assert.Equal(uint64(90+45), db.VoteBalance(nodes[1]))
+	assert.Equal(uint64(100-5-45), db.FreeBalance(nodes[2]))
+	assert.Equal(uint64(10-2-1), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(2+10+5+1), db.FreeBalance(pos.SPV))
+	assert.Len(db.GetSlashes(nodes[1]), 1)
+	assert.Len(db.GetSlashes(nodes[2]), 1)
+
+	// only once per offence
+	tx = slash(3)
+	assert.False(p.GetTransactionInfo(inter.TransactionHashOf(nodes[0], tx)).Applied)
+	assert.Equal(uint64(90+45), db.VoteBalance(nodes[1]))
+
+	store.SetForkProof(inter.NewForkProof(
+		&inter.Event{Index: 5, Creator: nodes[1]},
+		&inter.Event{Index: 5, Creator: nodes[1], LamportTime: 1},
+	))
+
+	tx = slash(4)
+	assert.True(p.GetTransactionInfo(inter.TransactionHashOf(nodes[0], tx)).Applied)
+	assert.Equal(uint64(81+41), db.VoteBalance(nodes[1]))
+	assert.Len(db.GetSlashes(nodes[1]), 2)
+}
+
+func TestPosetDelegations(t *testing.T) {
+	assert := assert.New(t)
+
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+	p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+		nodes[0]: 100,
+		nodes[1]: 100,
+		nodes[2]: 100,
+	}, &pos.Config{
+		TxFee: 1,
+	})
+
+	txs := []*inter.InternalTransaction{
+		{
+			Index:      1,
+			Amount:     20,
+			Receiver:   nodes[1],
+			UntilBlock: 50,
+		},
+		{
+			Index:      2,
+			Amount:     5,
+			Receiver:   nodes[1],
+			UntilBlock: 50,
+			Kind:       inter.UndelegateTx,
+		},
+		{
+			Index:      3,
+			Amount:     10,
+			Receiver:   nodes[2],
+			UntilBlock: 50,
+			Kind:       inter.RedelegateTx,
+			Source:     nodes[1],
+		},
+		{
+			Index:      4,
+			Amount:     10,
+			Receiver:   nodes[1],
+			UntilBlock: 40,
+			Kind:       inter.UndelegateTx,
+		},
+	}
+	e := &inter.Event{
+		Index:                1,
+		Creator:              nodes[0],
+		Parents:              hash.NewEvents(hash.ZeroEvent),
+		InternalTransactions: txs,
+	}
+	input.SetEvent(e)
+
+	db := store.StateDB(p.state.Genesis)
+	p.applyTransactions(db, 1, Events{&Event{Event: e}})
+
+	for i, applied := range []bool{true, true, true, false} {
+		info := p.GetTransactionInfo(inter.TransactionHashOf(nodes[0], txs[i]))
+		assert.Equal(applied, info.Applied, "tx %d", i)
+	}
+
+	assert.Equal([]*state.Delegation{
+		{Peer: nodes[0], Amount: 5, Until: 50},
+	}, db.GetDelegationRecs(nodes[1])[state.FROM])
+	assert.Equal([]*state.Delegation{
+		{Peer: nodes[0], Amount: 10, Until: 50},
+	}, db.GetDelegationRecs(nodes[2])[state.FROM])
+	assert.Equal(uint64(100-15-3), db.FreeBalance(nodes[0]))
+	assert.Equal(uint64(100+5), db.VoteBalance(nodes[1]))
+	assert.Equal(uint64(100+10), db.VoteBalance(nodes[2]))
+
+	// delegations are read from committed state of the last block
+	incoming, _ := p.GetDelegations(nodes[1])
+	assert.Empty(incoming)
+
+	root, err := db.Commit(true)
+	if !assert.NoError(err) {
+		return
+	}
+	store.SetBlock(&Block{Index: 1, Root: root})
+	p.state.LastBlockN = 1
+	p.saveState()
+
+	incoming, outgoing := p.GetDelegations(nodes[1])
+	assert.Equal([]*state.Delegation{
+		{Peer: nodes[0], Amount: 5, Until: 50},
+	}, incoming)
+	assert.Empty(outgoing)
+}
+
+func TestPosetValidatorRegistration(t *testing.T) {
+	assert := assert.New(t)
+
+	key := crypto.GenerateKey()
+	node := hash.PeerOfPubkey(key.Public())
+	p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+		node: 100,
+	}, &pos.Config{
+		TxFee: 1,
+	})
+
+	txs := []*inter.InternalTransaction{
+		{
+			Index:   1,
+			Kind:    inter.ValidatorRegistrationTx,
+			PubKey:  crypto.GenerateKey().Public().Bytes(),
+			Version: inter.InternalTxVersion,
+		},
+		{
+			Index:   2,
+			Kind:    inter.ValidatorRegistrationTx,
+			PubKey:  key.Public().Bytes(),
+			Version: inter.InternalTxVersion,
+		},
+	}
+	e := &inter.Event{
+		Index:                1,
+		Creator:              node,
+		Parents:              hash.NewEvents(hash.ZeroEvent),
+		InternalTransactions: txs,
+	}
+	input.SetEvent(e)
+
+	db := store.StateDB(p.state.Genesis)
+	p.applyTransactions(db, 1, Events{&Event{Event: e}})
+
+	for i, applied := range []bool{false, true} {
+		info := p.GetTransactionInfo(inter.TransactionHashOf(node, txs[i]))
+		assert.Equal(applied, info.Applied, "tx %d", i)
+	}
+
+	assert.Equal(key.Public().Bytes(), db.GetValidatorKey(node))
+	assert.Nil(p.GetValidatorKey(node), "block is not committed yet")
+
+	root, err := db.Commit(true)
+	if !assert.NoError(err) {
+		return
+	}
+	store.SetBlock(&Block{Index: 1, Root: root})
+	p.state.LastBlockN = 1
+	p.saveState()
+
+	assert.Equal(key.Public(), p.GetValidatorKey(node))
+	assert.Nil(p.GetValidatorKey(hash.FakePeer()))
+}
+
+func fakePosetWithBalances(balances map[hash.Peer]uint64, conf *pos.Config) (*Poset, *Store, *EventStore) {
+	store := NewMemStore()
+	if err := store.ApplyGenesis(balances); err != nil {
+		panic(err)
+	}
+
+	input := NewEventStore(nil, false)
+
+	p := New(store, input, conf, nil)
+	p.Bootstrap()
+
+	return p, store, input
+}
@@ -0,0 +1,145 @@
+package posposet
+
+import (
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
+)
+
+type (
+	// Validators is a stakes of epoch participants.
+	Validators map[hash.Peer]uint64
+)
+
+// TotalStake returns sum of stakes.
+func (vv Validators) TotalStake() (sum uint64) {
+	for _, stake := range vv {
+		sum += stake
+	}
+	return
+}
+
+// Equal returns true if validators and their stakes are the same.
+func (vv Validators) Equal(other Validators) bool {
+	if len(vv) != len(other) {
+		return false
+	}
+	for addr, stake := range vv {
+		if s, ok := other[addr]; !ok || s != stake {
+			return false
+		}
+	}
+	return true
+}
+
+// ToWire converts to simple map.
+func (vv Validators) ToWire() map[string]uint64 {
+	res := make(map[string]uint64, len(vv))
+
+	for addr, stake := range vv {
+		res[addr.Hex()] = stake
+	}
+
+	return res
+}
+
+// WireToValidators converts from wire.
+func WireToValidators(arr map[string]uint64) Validators {
+	res := make(Validators, len(arr))
+
+	for hex, stake := range arr {
+		addr := hash.HexToPeer(hex)
+		res[addr] = stake
+	}
+
+	return res
+}
+
+/*
+ * Poset's methods:
+ */
+
+// validatorsOf returns registered accounts with positive vote balance.
+// Genesis accounts (except SPV) are registered implicitly,
+// others have to register validator key in state by transaction.
+// Cheaters are not validators.
+func (p *Poset) validatorsOf(db *state.DB) Validators {
+	res := Validators{}
+	genesis := p.store.StateDB(p.state.Genesis)
+
+	db.ForEachAccount(func(addr hash.Peer) bool {
+		if addr == pos.SPV || p.state.Cheaters.Contains(addr) {
+			return true
+		}
+		if !genesis.Exist(addr) && db.GetValidatorKey(addr) == nil {
+			return true
+		}
+		if stake := db.VoteBalance(addr); stake > 0 {
+			res[addr] = stake
+		}
+		return true
+	})
+
+	return res
+}
+
+// GetEpoch returns current epoch number.
+func (p *Poset) GetEpoch() uint64 {
+	return p.state.Epoch
+}
+
+// GetValidators returns copy of validators of current epoch.
+func (p *Poset) GetValidators() Validators {
+	res := make(Validators, len(p.state.Validators))
+	for addr, stake := range p.state.Validators {
+		res[addr] = stake
+	}
+	return res
+}
+
+// isValidator returns true if peer is a validator of current epoch.
+func (p *Poset) isValidator(addr hash.Peer) bool {
+	_, ok := p.state.Validators[addr]
+	return ok
+}
+
+// sealEpoch starts a new epoch if last finished frame reached the epoch end.
+// Validators and their stakes are taken from the committed balances
+// of the last finished frame and keep unchanged during the epoch.
+// Cheaters caught during the previous epoch are excluded here only,
+// so stake counting keeps unchanged during the epoch.
+// It is not safe for concurrent use.
+func (p *Poset) sealEpoch() {
+	if p.conf.EpochLen == 0 {
+		return
+	}
+	epoch := p.state.LastFinishedFrameN / p.conf.EpochLen
+	if epoch <= p.state.Epoch {
+		return
+	}
+	p.state.Epoch = epoch
+
+	p.cheatersMu.Lock()
+	for addr := range p.state.NewCheaters {
+		p.state.Cheaters.Add(addr)
+	}
+	p.state.NewCheaters = Cheaters{}
+	p.cheatersMu.Unlock()
+
+	frame := p.frame(p.state.LastFinishedFrameN, false)
+	validators := p.validatorsOf(p.store.StateDB(frame.Balances))
+	if len(validators) == 0 {
+		p.Warnf("Epoch %d has no validators, the previous ones are kept", epoch)
+		p.saveState()
+		return
+	}
+
+	changed := !validators.Equal(p.state.Validators)
+	p.state.Validators = validators
+	p.state.TotalCap = validators.TotalStake()
+	p.saveState()
+
+	if changed {
+		p.reconsensusFromFrame(p.state.LastFinishedFrameN + 1)
+	}
+}
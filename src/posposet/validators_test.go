@@ -0,0 +1,112 @@
+package posposet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/pos"
+)
+
+func TestPosetValidators(t *testing.T) {
+	nodes := []hash.Peer{hash.FakePeer(), hash.FakePeer(), hash.FakePeer()}
+
+	t.Run("genesis", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, store, _ := fakePosetWithBalances(map[hash.Peer]uint64{
+			nodes[0]: 10,
+			nodes[1]: 20,
+			pos.SPV:  5,
+		}, nil)
+
+		expect := Validators{
+			nodes[0]: 10,
+			nodes[1]: 20,
+		}
+		assert.Equal(uint64(0), p.GetEpoch())
+		assert.Equal(expect, p.GetValidators())
+		assert.Equal(uint64(30), p.state.TotalCap)
+		assert.Equal(expect, store.GetState().Validators)
+	})
+
+	t.Run("non-validator", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, store, input := fakePosetWithBalances(map[hash.Peer]uint64{
+			nodes[0]: 10,
+		}, nil)
+
+		e := &inter.Event{
+			Index:   1,
+			Creator: nodes[1],
+			Parents: hash.NewEvents(hash.ZeroEvent),
+		}
+		input.SetEvent(e)
+		p.consensus(e)
+
+		assert.Nil(store.GetEventFrame(e.Hash()))
+	})
+
+	t.Run("epoch", func(t *testing.T) {
+		assert := assert.New(t)
+
+		p, store, _ := fakePosetWithBalances(map[hash.Peer]uint64{
+			nodes[0]: 10,
+			nodes[1]: 20,
+		}, &pos.Config{
+			EpochLen: 5,
+		})
+
+		db := store.StateDB(p.state.Genesis)
+		db.Transfer(nodes[1], nodes[2], 15)
+		db.Transfer(nodes[0], pos.SPV, 10)
+		balances, err := db.Commit(true)
+		if !assert.NoError(err) {
+			return
+		}
+
+		// epoch is not finished yet
+		p.frame(4, true).SetBalances(balances)
+		p.state.LastFinishedFrameN = 4
+		p.sealEpoch()
+		assert.Equal(uint64(0), p.GetEpoch())
+		assert.Equal(uint64(30), p.state.TotalCap)
+
+		p.frame(5, true)
+		p.state.LastFinishedFrameN = 5
+		p.sealEpoch()
+
+		// unregistered nodes[2] is not a validator
+		expect := Validators{
+			nodes[1]: 5,
+		}
+		assert.Equal(uint64(1), p.GetEpoch())
+		assert.Equal(expect, p.GetValidators())
+		assert.Equal(uint64(5), p.state.TotalCap)
+
+		restored := store.GetState()
+		assert.Equal(uint64(1), restored.Epoch)
+		assert.Equal(expect, restored.Validators)
+
+		// until it registers key
+		db.SetValidatorKey(nodes[2], []byte("key"))
+		balances, err = db.Commit(true)
+		if !assert.NoError(err) {
+			return
+		}
+		p.frame(10, true).SetBalances(balances)
+		p.state.LastFinishedFrameN = 10
+		p.sealEpoch()
+
+		expect = Validators{
+			nodes[1]: 5,
+			nodes[2]: 15,
+		}
+		assert.Equal(uint64(2), p.GetEpoch())
+		assert.Equal(expect, p.GetValidators())
+		assert.Equal(uint64(20), p.state.TotalCap)
+	})
+}
@@ -0,0 +1,133 @@
+// Package verifier checks posposet blocks without the events DAG.
+// It needs only block headers and the validators keys.
+package verifier
+
+import (
+	"fmt"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+)
+
+// Validators is a set of validators public keys.
+type Validators map[hash.Peer]*common.PublicKey
+
+// Verifier is a chain of verified block headers.
+type Verifier struct {
+	genesis    hash.Hash
+	validators Validators
+	headers    []*posposet.BlockHeader
+}
+
+// New makes verifier of chain from genesis.
+// Validators are the genesis ones, registered later could be added by AddValidator.
+func New(genesis hash.Hash, validators Validators) *Verifier {
+	return &Verifier{
+		genesis:    genesis,
+		validators: validators,
+	}
+}
+
+// AddHeader checks and appends the next block header.
+// Atropos is the signed event the header refers to.
+func (v *Verifier) AddHeader(h *posposet.BlockHeader, atropos *inter.Event) error {
+	prev := v.genesis
+	if last := v.Last(); last != nil {
+		prev = last.Hash()
+		if h.Time < last.Time {
+			return fmt.Errorf("block %d time %d is before previous %d", h.Index, h.Time, last.Time)
+		}
+	}
+
+	if h.Index != uint64(len(v.headers))+1 {
+		return fmt.Errorf("block %d is not the next one", h.Index)
+	}
+	if h.Prev != prev {
+		return fmt.Errorf("block %d is not linked to previous", h.Index)
+	}
+
+	if atropos == nil || atropos.Hash() != h.Atropos {
+		return fmt.Errorf("block %d has other Atropos", h.Index)
+	}
+	key := v.validators[atropos.Creator]
+	if key == nil {
+		return fmt.Errorf("Atropos of block %d is not created by validator", h.Index)
+	}
+	if !atropos.Verify(key) {
+		return fmt.Errorf("Atropos of block %d has invalid sign", h.Index)
+	}
+
+	v.headers = append(v.headers, h)
+	return nil
+}
+
+// Header returns verified header of block n.
+func (v *Verifier) Header(n uint64) *posposet.BlockHeader {
+	if n < 1 || n > uint64(len(v.headers)) {
+		return nil
+	}
+	return v.headers[n-1]
+}
+
+// Last returns the last verified header.
+func (v *Verifier) Last() *posposet.BlockHeader {
+	return v.Header(uint64(len(v.headers)))
+}
+
+// AddValidator takes registered public key of validator
+// from committed state of verified block.
+func (v *Verifier) AddValidator(addr hash.Peer, proof *posposet.AccountProof) error {
+	h := v.Header(proof.Block)
+	if h == nil {
+		return fmt.Errorf("block %d is not verified", proof.Block)
+	}
+
+	acc, err := proof.Verify(h.Root, addr)
+	if err != nil {
+		return err
+	}
+	if acc == nil || len(acc.ValidatorKey) == 0 {
+		return fmt.Errorf("%s has no registered key at block %d", addr.String(), proof.Block)
+	}
+
+	key := common.BytesToPubkey(acc.ValidatorKey)
+	if key.X == nil || hash.PeerOfPubkey(key) != addr {
+		return fmt.Errorf("registered key of %s is invalid", addr.String())
+	}
+
+	v.validators[addr] = key
+	return nil
+}
+
+// VerifyEvent checks event is in block.
+func (v *Verifier) VerifyEvent(e hash.Event, proof *posposet.EventProof) error {
+	h := v.Header(proof.Block)
+	if h == nil {
+		return fmt.Errorf("block %d is not verified", proof.Block)
+	}
+	return proof.Verify(h.EventsRoot, e)
+}
+
+// VerifyBalance checks account balance at block.
+func (v *Verifier) VerifyBalance(addr hash.Peer, balance uint64, proof *posposet.AccountProof) error {
+	h := v.Header(proof.Block)
+	if h == nil {
+		return fmt.Errorf("block %d is not verified", proof.Block)
+	}
+
+	acc, err := proof.Verify(h.Root, addr)
+	if err != nil {
+		return err
+	}
+
+	var got uint64
+	if acc != nil {
+		got = acc.Balance
+	}
+	if got != balance {
+		return fmt.Errorf("balance of %s is %d at block %d", addr.String(), got, proof.Block)
+	}
+	return nil
+}
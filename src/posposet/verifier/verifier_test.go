@@ -0,0 +1,136 @@
+package verifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Fantom-foundation/go-lachesis/src/common"
+	"github.com/Fantom-foundation/go-lachesis/src/crypto"
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+)
+
+func TestVerifier(t *testing.T) {
+	key := crypto.GenerateKey()
+	peer := hash.PeerOfPubkey(key.Public())
+	genesis := hash.FakeHash()
+
+	t.Run("header chain", func(t *testing.T) {
+		assert := assert.New(t)
+
+		v := New(genesis, Validators{peer: key.Public()})
+		prev := genesis
+		for n := uint64(1); n <= 3; n++ {
+			atropos := fakeAtropos(key, n)
+			h := fakeHeader(n, prev, atropos)
+			if !assert.NoError(v.AddHeader(h, atropos)) {
+				return
+			}
+			prev = h.Hash()
+		}
+		assert.Equal(prev, v.Last().Hash())
+		assert.Nil(v.Header(4))
+	})
+
+	t.Run("invalid headers", func(t *testing.T) {
+		assert := assert.New(t)
+
+		v := New(genesis, Validators{peer: key.Public()})
+		atropos := fakeAtropos(key, 1)
+
+		assert.Error(v.AddHeader(fakeHeader(2, genesis, atropos), atropos), "not the next")
+		assert.Error(v.AddHeader(fakeHeader(1, hash.FakeHash(), atropos), atropos), "not linked")
+		assert.Error(v.AddHeader(fakeHeader(1, genesis, atropos), fakeAtropos(key, 2)), "other atropos")
+
+		other := crypto.GenerateKey()
+		foreign := fakeAtropos(other, 1)
+		assert.Error(v.AddHeader(fakeHeader(1, genesis, foreign), foreign), "not a validator")
+
+		forged := fakeAtropos(key, 1)
+		if !assert.NoError(forged.SignBy(other)) {
+			return
+		}
+		assert.Error(v.AddHeader(fakeHeader(1, genesis, forged), forged), "invalid sign")
+
+		assert.Nil(v.Last())
+	})
+
+	t.Run("registered validator", func(t *testing.T) {
+		assert := assert.New(t)
+
+		registered := crypto.GenerateKey()
+		addr := hash.PeerOfPubkey(registered.Public())
+		unknown := hash.FakePeer()
+
+		db := posposet.NewMemStore().StateDB(hash.Hash{})
+		db.SetValidatorKey(addr, registered.Public().Bytes())
+		root, err := db.Commit(true)
+		if !assert.NoError(err) {
+			return
+		}
+		prove := func(addr hash.Peer) *posposet.AccountProof {
+			nodes, err := db.GetProof(addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return &posposet.AccountProof{Block: 1, Nodes: nodes}
+		}
+
+		v := New(genesis, Validators{peer: key.Public()})
+		assert.Error(v.AddValidator(addr, prove(addr)), "unverified block")
+
+		atropos := fakeAtropos(key, 1)
+		h := fakeHeader(1, genesis, atropos)
+		h.Root = root
+		if !assert.NoError(v.AddHeader(h, atropos)) {
+			return
+		}
+
+		assert.Error(v.AddValidator(unknown, prove(unknown)), "not registered")
+		if !assert.NoError(v.AddValidator(addr, prove(addr))) {
+			return
+		}
+
+		next := fakeAtropos(registered, 2)
+		assert.NoError(v.AddHeader(fakeHeader(2, h.Hash(), next), next), "registered validator's Atropos")
+	})
+
+	t.Run("proofs of unverified block", func(t *testing.T) {
+		assert := assert.New(t)
+
+		v := New(genesis, Validators{peer: key.Public()})
+
+		assert.Error(v.VerifyEvent(hash.FakeEvent(), &posposet.EventProof{Block: 1}))
+		assert.Error(v.VerifyBalance(peer, 0, &posposet.AccountProof{Block: 1}))
+	})
+}
+
+/*
+ * Utils:
+ */
+
+func fakeAtropos(key *common.PrivateKey, n uint64) *inter.Event {
+	e := &inter.Event{
+		Index:       n,
+		Creator:     hash.PeerOfPubkey(key.Public()),
+		LamportTime: inter.Timestamp(n),
+	}
+	if err := e.SignBy(key); err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func fakeHeader(n uint64, prev hash.Hash, atropos *inter.Event) *posposet.BlockHeader {
+	return &posposet.BlockHeader{
+		Index:      n,
+		Prev:       prev,
+		Frame:      n,
+		Atropos:    atropos.Hash(),
+		Time:       atropos.LamportTime,
+		EventsRoot: hash.FakeHash(),
+		Root:       hash.FakeHash(),
+	}
+}
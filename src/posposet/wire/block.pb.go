@@ -23,6 +23,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type Block struct {
 	Index                uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
 	Events               [][]byte `protobuf:"bytes,2,rep,name=Events,proto3" json:"Events,omitempty"`
+	Frame                uint64   `protobuf:"varint,3,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Atropos              []byte   `protobuf:"bytes,4,opt,name=Atropos,proto3" json:"Atropos,omitempty"`
+	Time                 uint64   `protobuf:"varint,5,opt,name=Time,proto3" json:"Time,omitempty"`
+	Root                 []byte   `protobuf:"bytes,6,opt,name=Root,proto3" json:"Root,omitempty"`
+	Prev                 []byte   `protobuf:"bytes,7,opt,name=Prev,proto3" json:"Prev,omitempty"`
+	EventsRoot           []byte   `protobuf:"bytes,8,opt,name=EventsRoot,proto3" json:"EventsRoot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -67,18 +73,155 @@ func (m *Block) GetEvents() [][]byte {
 	return nil
 }
 
+func (m *Block) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *Block) GetAtropos() []byte {
+	if m != nil {
+		return m.Atropos
+	}
+	return nil
+}
+
+func (m *Block) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *Block) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *Block) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *Block) GetEventsRoot() []byte {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return nil
+}
+
+type BlockHeader struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Prev                 []byte   `protobuf:"bytes,2,opt,name=Prev,proto3" json:"Prev,omitempty"`
+	Frame                uint64   `protobuf:"varint,3,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Atropos              []byte   `protobuf:"bytes,4,opt,name=Atropos,proto3" json:"Atropos,omitempty"`
+	Time                 uint64   `protobuf:"varint,5,opt,name=Time,proto3" json:"Time,omitempty"`
+	EventsRoot           []byte   `protobuf:"bytes,6,opt,name=EventsRoot,proto3" json:"EventsRoot,omitempty"`
+	Root                 []byte   `protobuf:"bytes,7,opt,name=Root,proto3" json:"Root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
+func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BlockHeader) ProtoMessage()    {}
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e550b1f5926e92d, []int{1}
+}
+
+func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockHeader.Unmarshal(m, b)
+}
+func (m *BlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockHeader.Marshal(b, m, deterministic)
+}
+func (m *BlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeader.Merge(m, src)
+}
+func (m *BlockHeader) XXX_Size() int {
+	return xxx_messageInfo_BlockHeader.Size(m)
+}
+func (m *BlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeader proto.InternalMessageInfo
+
+func (m *BlockHeader) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetAtropos() []byte {
+	if m != nil {
+		return m.Atropos
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetEventsRoot() []byte {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return nil
+}
+
+func (m *BlockHeader) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Block)(nil), "wire.Block")
+	proto.RegisterType((*BlockHeader)(nil), "wire.BlockHeader")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptor_8e550b1f5926e92d) }
 
 var fileDescriptor_8e550b1f5926e92d = []byte{
-	// 92 bytes of a gzipped FileDescriptorProto
+	// 207 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0xca, 0xc9, 0x4f,
-	0xce, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x29, 0xcf, 0x2c, 0x4a, 0x55, 0x32, 0xe5,
-	0x62, 0x75, 0x02, 0x09, 0x0a, 0x89, 0x70, 0xb1, 0x7a, 0xe6, 0xa5, 0xa4, 0x56, 0x48, 0x30, 0x2a,
-	0x30, 0x6a, 0xb0, 0x04, 0x41, 0x38, 0x42, 0x62, 0x5c, 0x6c, 0xae, 0x65, 0xa9, 0x79, 0x25, 0xc5,
-	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x3c, 0x41, 0x50, 0x5e, 0x12, 0x1b, 0xd8, 0x0c, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf1, 0xb1, 0x9b, 0x13, 0x52, 0x00, 0x00, 0x00,
+	0xce, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x29, 0xcf, 0x2c, 0x4a, 0x55, 0x3a, 0xc8,
+	0xc8, 0xc5, 0xea, 0x04, 0x12, 0x15, 0x12, 0xe1, 0x62, 0xf5, 0xcc, 0x4b, 0x49, 0xad, 0x90, 0x60,
+	0x54, 0x60, 0xd4, 0x60, 0x09, 0x82, 0x70, 0x84, 0xc4, 0xb8, 0xd8, 0x5c, 0xcb, 0x52, 0xf3, 0x4a,
+	0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0x78, 0x82, 0xa0, 0x3c, 0x90, 0x6a, 0xb7, 0xa2, 0xc4, 0xdc,
+	0x54, 0x09, 0x66, 0x88, 0x6a, 0x30, 0x47, 0x48, 0x82, 0x8b, 0xdd, 0xb1, 0xa4, 0x28, 0xbf, 0x20,
+	0xbf, 0x58, 0x82, 0x45, 0x81, 0x51, 0x83, 0x27, 0x08, 0xc6, 0x15, 0x12, 0xe2, 0x62, 0x09, 0xc9,
+	0xcc, 0x4d, 0x95, 0x60, 0x05, 0x2b, 0x07, 0xb3, 0x41, 0x62, 0x41, 0xf9, 0xf9, 0x25, 0x12, 0x6c,
+	0x60, 0xa5, 0x60, 0x36, 0x48, 0x2c, 0xa0, 0x28, 0xb5, 0x4c, 0x82, 0x1d, 0x22, 0x06, 0x62, 0x0b,
+	0xc9, 0x71, 0x71, 0x41, 0x6c, 0x05, 0xab, 0xe6, 0x00, 0xcb, 0x20, 0x89, 0x28, 0xad, 0x67, 0xe4,
+	0xe2, 0x06, 0xfb, 0xc1, 0x23, 0x35, 0x31, 0x25, 0xb5, 0x08, 0x87, 0x4f, 0x60, 0x26, 0x33, 0x21,
+	0x99, 0x4c, 0x0d, 0x5f, 0xa0, 0xba, 0x8e, 0x0d, 0xdd, 0x75, 0x70, 0x5f, 0xb2, 0x23, 0x7c, 0x99,
+	0xc4, 0x06, 0x8e, 0x02, 0x63, 0xc0, 0x00, 0x8b, 0x09, 0x27, 0x60, 0x91, 0x01, 0x00, 0x00,
 }
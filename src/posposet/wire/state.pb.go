@@ -21,13 +21,17 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type State struct {
-	LastFinishedFrameN   uint64   `protobuf:"varint,1,opt,name=LastFinishedFrameN,proto3" json:"LastFinishedFrameN,omitempty"`
-	LastBlockN           uint64   `protobuf:"varint,2,opt,name=LastBlockN,proto3" json:"LastBlockN,omitempty"`
-	Genesis              []byte   `protobuf:"bytes,3,opt,name=Genesis,proto3" json:"Genesis,omitempty"`
-	TotalCap             uint64   `protobuf:"varint,4,opt,name=TotalCap,proto3" json:"TotalCap,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LastFinishedFrameN   uint64            `protobuf:"varint,1,opt,name=LastFinishedFrameN,proto3" json:"LastFinishedFrameN,omitempty"`
+	LastBlockN           uint64            `protobuf:"varint,2,opt,name=LastBlockN,proto3" json:"LastBlockN,omitempty"`
+	Genesis              []byte            `protobuf:"bytes,3,opt,name=Genesis,proto3" json:"Genesis,omitempty"`
+	TotalCap             uint64            `protobuf:"varint,4,opt,name=TotalCap,proto3" json:"TotalCap,omitempty"`
+	Epoch                uint64            `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Validators           map[string]uint64 `protobuf:"bytes,6,rep,name=Validators,proto3" json:"Validators,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Cheaters             [][]byte          `protobuf:"bytes,7,rep,name=Cheaters,proto3" json:"Cheaters,omitempty"`
+	NewCheaters          [][]byte          `protobuf:"bytes,8,rep,name=NewCheaters,proto3" json:"NewCheaters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -83,21 +87,57 @@ func (m *State) GetTotalCap() uint64 {
 	return 0
 }
 
+func (m *State) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *State) GetValidators() map[string]uint64 {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *State) GetCheaters() [][]byte {
+	if m != nil {
+		return m.Cheaters
+	}
+	return nil
+}
+
+func (m *State) GetNewCheaters() [][]byte {
+	if m != nil {
+		return m.NewCheaters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*State)(nil), "wire.State")
+	proto.RegisterMapType((map[string]uint64)(nil), "wire.State.ValidatorsEntry")
 }
 
 func init() { proto.RegisterFile("state.proto", fileDescriptor_a888679467bb7853) }
 
 var fileDescriptor_a888679467bb7853 = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2e, 0x49, 0x2c,
-	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x29, 0xcf, 0x2c, 0x4a, 0x55, 0xea, 0x65,
-	0xe4, 0x62, 0x0d, 0x06, 0x89, 0x0a, 0xe9, 0x71, 0x09, 0xf9, 0x24, 0x16, 0x97, 0xb8, 0x65, 0xe6,
-	0x65, 0x16, 0x67, 0xa4, 0xa6, 0xb8, 0x15, 0x25, 0xe6, 0xa6, 0xfa, 0x49, 0x30, 0x2a, 0x30, 0x6a,
-	0xb0, 0x04, 0x61, 0x91, 0x11, 0x92, 0xe3, 0xe2, 0x02, 0x89, 0x3a, 0xe5, 0xe4, 0x27, 0x67, 0xfb,
-	0x49, 0x30, 0x81, 0xd5, 0x21, 0x89, 0x08, 0x49, 0x70, 0xb1, 0xbb, 0xa7, 0xe6, 0xa5, 0x16, 0x67,
-	0x16, 0x4b, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xb8, 0x42, 0x52, 0x5c, 0x1c, 0x21, 0xf9,
-	0x25, 0x89, 0x39, 0xce, 0x89, 0x05, 0x12, 0x2c, 0x60, 0x7d, 0x70, 0x7e, 0x12, 0x1b, 0xd8, 0x71,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x30, 0x2f, 0x9b, 0x04, 0xab, 0x00, 0x00, 0x00,
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0xc7, 0xe9, 0xda, 0x6e, 0xf3, 0x75, 0xa0, 0x3c, 0x3c, 0x84, 0x09, 0x12, 0x3c, 0xf5, 0x94,
+	0x83, 0x5e, 0x44, 0xf1, 0xe2, 0xd8, 0xbc, 0x48, 0x0f, 0x51, 0xbc, 0xc7, 0xed, 0x41, 0xc3, 0x6a,
+	0x53, 0x92, 0xe8, 0xd8, 0x87, 0xf5, 0xbb, 0x48, 0x32, 0xad, 0x45, 0xbc, 0xf5, 0xf7, 0xff, 0xbd,
+	0x26, 0x2f, 0x7f, 0x28, 0x9c, 0x57, 0x9e, 0x44, 0x67, 0x8d, 0x37, 0x98, 0xed, 0xb4, 0xa5, 0x8b,
+	0xcf, 0x11, 0xe4, 0x4f, 0x21, 0x45, 0x01, 0xf8, 0xa8, 0x9c, 0x5f, 0xe9, 0x56, 0xbb, 0x9a, 0x36,
+	0x2b, 0xab, 0xde, 0xa8, 0x62, 0x09, 0x4f, 0xca, 0x4c, 0xfe, 0x63, 0xf0, 0x1c, 0x20, 0xa4, 0xf7,
+	0x8d, 0x59, 0x6f, 0x2b, 0x36, 0x8a, 0x73, 0x83, 0x04, 0x19, 0x4c, 0x1e, 0xa8, 0x25, 0xa7, 0x1d,
+	0x4b, 0x79, 0x52, 0xce, 0xe4, 0x0f, 0xe2, 0x1c, 0xa6, 0xcf, 0xc6, 0xab, 0x66, 0xa1, 0x3a, 0x96,
+	0xc5, 0xff, 0x7a, 0xc6, 0x53, 0xc8, 0x97, 0x9d, 0x59, 0xd7, 0x2c, 0x8f, 0xe2, 0x00, 0x78, 0x0b,
+	0xf0, 0xa2, 0x1a, 0xbd, 0x51, 0xde, 0x58, 0xc7, 0xc6, 0x3c, 0x2d, 0x8b, 0xcb, 0x33, 0x11, 0x1e,
+	0x20, 0xe2, 0xf2, 0xe2, 0xd7, 0x2e, 0x5b, 0x6f, 0xf7, 0x72, 0x30, 0x1e, 0xae, 0x5b, 0xd4, 0xa4,
+	0x3c, 0x59, 0xc7, 0x26, 0x3c, 0x2d, 0x67, 0xb2, 0x67, 0xe4, 0x50, 0x54, 0xb4, 0xeb, 0xf5, 0x34,
+	0xea, 0x61, 0x34, 0xbf, 0x83, 0xe3, 0x3f, 0x87, 0xe3, 0x09, 0xa4, 0x5b, 0xda, 0xc7, 0x6a, 0x8e,
+	0x64, 0xf8, 0x0c, 0x5b, 0x7f, 0xa8, 0xe6, 0x9d, 0xbe, 0x6b, 0x38, 0xc0, 0xcd, 0xe8, 0x3a, 0x79,
+	0x1d, 0xc7, 0xb2, 0xaf, 0xbe, 0x06, 0x00, 0x79, 0x1e, 0x32, 0x9b, 0x7b, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: transaction.proto
+
+package wire
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type TransactionInfo struct {
+	Event                []byte   `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty"`
+	Position             uint32   `protobuf:"varint,2,opt,name=Position,proto3" json:"Position,omitempty"`
+	Block                uint64   `protobuf:"varint,3,opt,name=Block,proto3" json:"Block,omitempty"`
+	Frame                uint64   `protobuf:"varint,4,opt,name=Frame,proto3" json:"Frame,omitempty"`
+	Applied              bool     `protobuf:"varint,5,opt,name=Applied,proto3" json:"Applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
+func (m *TransactionInfo) String() string { return proto.CompactTextString(m) }
+func (*TransactionInfo) ProtoMessage()    {}
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2cc4e03d2c28c490, []int{0}
+}
+
+func (m *TransactionInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionInfo.Unmarshal(m, b)
+}
+func (m *TransactionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionInfo.Marshal(b, m, deterministic)
+}
+func (m *TransactionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionInfo.Merge(m, src)
+}
+func (m *TransactionInfo) XXX_Size() int {
+	return xxx_messageInfo_TransactionInfo.Size(m)
+}
+func (m *TransactionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionInfo proto.InternalMessageInfo
+
+func (m *TransactionInfo) GetEvent() []byte {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *TransactionInfo) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *TransactionInfo) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TransactionInfo)(nil), "wire.TransactionInfo")
+}
+
+func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
+
+var fileDescriptor_2cc4e03d2c28c490 = []byte{
+	// 148 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2c, 0x29, 0x4a, 0xcc,
+	0x2b, 0x4e, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x29,
+	0xcf, 0x2c, 0x4a, 0x55, 0xea, 0x64, 0xe4, 0xe2, 0x0f, 0x41, 0xc8, 0x79, 0xe6, 0xa5, 0xe5, 0x0b,
+	0x89, 0x70, 0xb1, 0xba, 0x96, 0xa5, 0xe6, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0x41,
+	0x38, 0x42, 0x52, 0x5c, 0x1c, 0x01, 0xf9, 0xc5, 0x99, 0x20, 0x55, 0x12, 0x4c, 0x0a, 0x8c, 0x1a,
+	0xbc, 0x41, 0x70, 0x3e, 0x48, 0x87, 0x53, 0x4e, 0x7e, 0x72, 0xb6, 0x04, 0xb3, 0x02, 0xa3, 0x06,
+	0x4b, 0x10, 0x84, 0x03, 0x12, 0x75, 0x2b, 0x4a, 0xcc, 0x4d, 0x95, 0x60, 0x81, 0x88, 0x82, 0x39,
+	0x42, 0x12, 0x5c, 0xec, 0x8e, 0x05, 0x05, 0x39, 0x99, 0xa9, 0x29, 0x12, 0xac, 0x0a, 0x8c, 0x1a,
+	0x1c, 0x41, 0x30, 0x6e, 0x12, 0x1b, 0xd8, 0x61, 0xc6, 0x80, 0x01, 0x00, 0x1e, 0x69, 0x55, 0xe6,
+	0xad, 0x00, 0x00, 0x00,
+}
@@ -1,3 +1,3 @@
 package wire
 
-//go:generate protoc --go_out=plugins=grpc:./ state.proto block.proto frame.proto
+//go:generate protoc --go_out=plugins=grpc:./ state.proto block.proto frame.proto transaction.proto
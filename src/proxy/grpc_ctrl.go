@@ -12,11 +12,17 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	"github.com/Fantom-foundation/go-lachesis/src/logger"
 	"github.com/Fantom-foundation/go-lachesis/src/network"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 	"github.com/Fantom-foundation/go-lachesis/src/proxy/internal"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
+// finalityBuffer is a count of notifications to hold for slow Finality stream.
+const finalityBuffer = 100
+
 // grpcCtrlProxy implements CtrlProxy interface.
 type grpcCtrlProxy struct {
 	node      Node
@@ -113,6 +119,31 @@ func (p *grpcCtrlProxy) TransactionInfo(_ context.Context, req *internal.Transac
 	}, nil
 }
 
+// BlockInfo returns info about block.
+func (p *grpcCtrlProxy) BlockInfo(_ context.Context, req *internal.BlockRequest) (*internal.BlockResponse, error) {
+	b := p.consensus.GetBlock(req.Index)
+
+	if b == nil {
+		return nil, status.Error(codes.NotFound, "block not found")
+	}
+
+	events := make([]string, len(b.Events))
+	for i, e := range b.Events {
+		events[i] = e.Hex()
+	}
+
+	return &internal.BlockResponse{
+		Index:      b.Index,
+		Prev:       b.Prev.Hex(),
+		Frame:      b.Frame,
+		Atropos:    b.Atropos.Hex(),
+		Time:       uint64(b.Time),
+		EventsRoot: b.EventsRoot.Hex(),
+		Root:       b.Root.Hex(),
+		Events:     events,
+	}, nil
+}
+
 // SendTo makes stake transfer transaction.
 // TODO: replace TransferRequest with inter/wire.InternalTransaction
 func (p *grpcCtrlProxy) SendTo(_ context.Context, req *internal.TransferRequest) (*internal.TransferResponse, error) {
@@ -121,6 +152,11 @@ func (p *grpcCtrlProxy) SendTo(_ context.Context, req *internal.TransferRequest)
 		Amount:     req.Amount,
 		Receiver:   hash.HexToPeer(req.Receiver.Hex),
 		UntilBlock: req.Until,
+		Kind:       inter.InternalTransactionKind(req.Kind),
+		Version:    inter.InternalTxVersion,
+	}
+	if req.Source != nil {
+		tx.Source = hash.HexToPeer(req.Source.Hex)
 	}
 
 	h, err := p.node.AddInternalTxn(tx)
@@ -135,3 +171,178 @@ func (p *grpcCtrlProxy) SetLogLevel(_ context.Context, req *internal.LogLevel) (
 	logger.SetLevel(req.Level)
 	return &empty.Empty{}, nil
 }
+
+// Cheaters returns peers caught on forks.
+func (p *grpcCtrlProxy) Cheaters(_ context.Context, _ *empty.Empty) (*internal.IDs, error) {
+	cheaters := p.consensus.GetCheaters()
+
+	res := &internal.IDs{
+		Ids: make([]*internal.ID, len(cheaters)),
+	}
+	for i, id := range cheaters {
+		res.Ids[i] = &internal.ID{
+			Hex: id.Hex(),
+		}
+	}
+
+	return res, nil
+}
+
+// ForkProof returns cheating evidence of peer.
+func (p *grpcCtrlProxy) ForkProof(_ context.Context, req *internal.ID) (*wire.ForkProof, error) {
+	id := hash.HexToPeer(req.Hex)
+	proof := p.consensus.GetForkProof(id)
+
+	if proof == nil {
+		return nil, status.Error(codes.NotFound, "fork proof not found")
+	}
+
+	return proof.ToWire(), nil
+}
+
+// Delegations returns incoming and outgoing delegations of peer.
+func (p *grpcCtrlProxy) Delegations(_ context.Context, req *internal.ID) (*internal.DelegationsResponse, error) {
+	id := hash.HexToPeer(req.Hex)
+	incoming, outgoing := p.consensus.GetDelegations(id)
+
+	return &internal.DelegationsResponse{
+		Incoming: delegationsToWire(incoming),
+		Outgoing: delegationsToWire(outgoing),
+	}, nil
+}
+
+// Finality streams finality notifications.
+// Stream ends when all the requested events and transactions are confirmed
+// and no blocks or Atroposes are requested.
+// Slow stream is disconnected.
+func (p *grpcCtrlProxy) Finality(req *internal.FinalityRequest, stream internal.Node_FinalityServer) error {
+	var kinds posposet.NotificationKind
+	if req.Blocks {
+		kinds |= posposet.BlockDecided
+	}
+	if req.Atroposes {
+		kinds |= posposet.AtroposDecided
+	}
+	events := hash.Events{}
+	for _, hex := range req.Events {
+		events.Add(hash.HexToEventHash(hex))
+	}
+	if len(events) > 0 {
+		kinds |= posposet.EventConfirmed
+	}
+	txns := make(map[hash.Transaction]struct{}, len(req.Transactions))
+	for _, hex := range req.Transactions {
+		txns[hash.HexToTransactionHash(hex)] = struct{}{}
+	}
+	if len(txns) > 0 {
+		kinds |= posposet.BlockDecided
+	}
+
+	// subscribe before check to not miss confirmation
+	sub := p.consensus.Subscribe(kinds, finalityBuffer, posposet.DisconnectSlow)
+	defer sub.Unsubscribe()
+
+	// already confirmed transactions
+	sendTxns := func() error {
+		for h := range txns {
+			info := p.consensus.GetTransactionInfo(h)
+			if info == nil {
+				continue
+			}
+			delete(txns, h)
+			err := stream.Send(&internal.FinalityNotification{
+				Kind:    internal.FinalityNotification_TRANSACTION,
+				Block:   info.Block,
+				Frame:   info.Frame,
+				Hex:     h.Hex(),
+				Applied: info.Applied,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := sendTxns(); err != nil {
+		return err
+	}
+
+	for {
+		if !req.Blocks && !req.Atroposes && len(events) < 1 && len(txns) < 1 {
+			return nil
+		}
+
+		var (
+			n  posposet.Notification
+			ok bool
+		)
+		select {
+		case n, ok = <-sub.C:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "too slow finality consumer")
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+
+		w := &internal.FinalityNotification{
+			Block: n.Block,
+			Frame: n.Frame,
+		}
+		switch n.Kind {
+		case posposet.BlockDecided:
+			if err := sendTxns(); err != nil {
+				return err
+			}
+			if !req.Blocks {
+				continue
+			}
+			w.Kind = internal.FinalityNotification_BLOCK
+		case posposet.AtroposDecided:
+			w.Kind = internal.FinalityNotification_ATROPOS
+			w.Hex = n.Event.Hex()
+			w.Time = uint64(n.Time)
+		case posposet.EventConfirmed:
+			if !events.Contains(n.Event) {
+				continue
+			}
+			delete(events, n.Event)
+			w.Kind = internal.FinalityNotification_EVENT
+			w.Hex = n.Event.Hex()
+		}
+
+		if err := stream.Send(w); err != nil {
+			return err
+		}
+	}
+}
+
+/*
+ * Utils:
+ */
+
+func delegationsToWire(dd []*state.Delegation) []*internal.Delegation {
+	res := make([]*internal.Delegation, len(dd))
+	for i, d := range dd {
+		res[i] = &internal.Delegation{
+			Peer: &internal.ID{
+				Hex: d.Peer.Hex(),
+			},
+			Amount: d.Amount,
+			Until:  d.Until,
+		}
+	}
+	return res
+}
+
+func wireToDelegations(ww []*internal.Delegation) []*state.Delegation {
+	res := make([]*state.Delegation, len(ww))
+	for i, w := range ww {
+		res[i] = &state.Delegation{
+			Peer:   hash.HexToPeer(w.Peer.Hex),
+			Amount: w.Amount,
+			Until:  w.Until,
+		}
+	}
+	return res
+}
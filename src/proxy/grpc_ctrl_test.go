@@ -3,6 +3,7 @@ package proxy
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,8 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/logger"
 	"github.com/Fantom-foundation/go-lachesis/src/network"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 func TestGrpcCtrlCalls(t *testing.T) {
@@ -113,6 +116,43 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 		assert.Equal(expect, got)
 	})
 
+	t.Run("block not found", func(t *testing.T) {
+		assert := assert.New(t)
+
+		consensus.EXPECT().
+			GetBlock(uint64(1)).
+			Return(nil)
+
+		_, err := client.GetBlock(1)
+		assert.Error(err)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		assert := assert.New(t)
+
+		expect := &posposet.Block{
+			Index:      1,
+			Prev:       hash.FakeHash(),
+			Frame:      rand.Uint64(),
+			Atropos:    hash.FakeEvent(),
+			Time:       inter.Timestamp(rand.Uint64()),
+			EventsRoot: hash.FakeHash(),
+			Root:       hash.FakeHash(),
+			Events:     hash.FakeEvents(3).Slice(),
+		}
+
+		consensus.EXPECT().
+			GetBlock(expect.Index).
+			Return(expect)
+
+		got, err := client.GetBlock(expect.Index)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal(expect, got)
+	})
+
 	t.Run("get balance of self", func(t *testing.T) {
 		assert := assert.New(t)
 
@@ -138,6 +178,7 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 			Index:    1,
 			Amount:   amount,
 			Receiver: peer,
+			Version:  inter.InternalTxVersion,
 		}
 
 		node.EXPECT().
@@ -147,6 +188,158 @@ func testGrpcCtrlCalls(t *testing.T, listen network.ListenFunc, opts ...grpc.Dia
 		assert.NoError(err)
 	})
 
+	t.Run("undelegate", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:      2,
+			Amount:     rand.Uint64(),
+			Receiver:   peer,
+			UntilBlock: 10,
+			Kind:       inter.UndelegateTx,
+			Version:    inter.InternalTxVersion,
+		}
+
+		node.EXPECT().
+			AddInternalTxn(tx)
+
+		_, err := client.Undelegate(tx.Receiver, tx.Index, tx.Amount, tx.UntilBlock)
+		assert.NoError(err)
+	})
+
+	t.Run("redelegate", func(t *testing.T) {
+		assert := assert.New(t)
+
+		tx := inter.InternalTransaction{
+			Index:      3,
+			Amount:     rand.Uint64(),
+			Receiver:   hash.FakePeer(),
+			UntilBlock: 10,
+			Kind:       inter.RedelegateTx,
+			Source:     peer,
+			Version:    inter.InternalTxVersion,
+		}
+
+		node.EXPECT().
+			AddInternalTxn(tx)
+
+		_, err := client.Redelegate(tx.Source, tx.Receiver, tx.Index, tx.Amount, tx.UntilBlock)
+		assert.NoError(err)
+	})
+
+	t.Run("delegations", func(t *testing.T) {
+		assert := assert.New(t)
+
+		incoming := []*state.Delegation{
+			{Peer: hash.FakePeer(), Amount: 1, Until: 10},
+			{Peer: hash.FakePeer(), Amount: 2, Until: 20},
+		}
+		outgoing := []*state.Delegation{
+			{Peer: hash.FakePeer(), Amount: 3, Until: 30},
+		}
+
+		consensus.EXPECT().
+			GetDelegations(peer).
+			Return(incoming, outgoing)
+
+		gotIn, gotOut, err := client.GetDelegations(peer)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal(incoming, gotIn)
+		assert.Equal(outgoing, gotOut)
+	})
+
+	t.Run("cheaters", func(t *testing.T) {
+		assert := assert.New(t)
+
+		expect := []hash.Peer{hash.FakePeer(), hash.FakePeer()}
+
+		consensus.EXPECT().
+			GetCheaters().
+			Return(expect)
+
+		got, err := client.GetCheaters()
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal(expect, got)
+	})
+
+	t.Run("fork proof not found", func(t *testing.T) {
+		assert := assert.New(t)
+
+		consensus.EXPECT().
+			GetForkProof(peer).
+			Return(nil)
+
+		_, err := client.GetForkProof(peer)
+		assert.Error(err)
+	})
+
+	t.Run("fork proof", func(t *testing.T) {
+		assert := assert.New(t)
+
+		expect := inter.NewForkProof(
+			&inter.Event{Index: 1, Creator: peer, LamportTime: 1},
+			&inter.Event{Index: 1, Creator: peer, LamportTime: 2},
+		)
+
+		consensus.EXPECT().
+			GetForkProof(peer).
+			Return(expect)
+
+		got, err := client.GetForkProof(peer)
+		if !assert.NoError(err) {
+			return
+		}
+
+		assert.Equal(expect.First.Hash(), got.First.Hash())
+		assert.Equal(expect.Second.Hash(), got.Second.Hash())
+	})
+
+	t.Run("wait transaction", func(t *testing.T) {
+		assert := assert.New(t)
+
+		h := hash.FakeTransaction()
+		expect := &posposet.TransactionInfo{
+			Block:   rand.Uint64(),
+			Frame:   rand.Uint64(),
+			Applied: true,
+		}
+
+		consensus.EXPECT().
+			Subscribe(posposet.BlockDecided, gomock.Any(), posposet.DisconnectSlow).
+			DoAndReturn(func(kinds posposet.NotificationKind, buffer int, policy posposet.SlowPolicy) *posposet.Subscription {
+				return posposet.New(posposet.NewMemStore(), nil, nil, nil).
+					Subscribe(kinds, buffer, policy)
+			}).
+			Times(2)
+		consensus.EXPECT().
+			GetTransactionInfo(h).
+			Return(expect)
+
+		got, err := client.WaitTransaction(h, time.Second)
+		if !assert.NoError(err) {
+			return
+		}
+		assert.Equal(expect.Block, got)
+
+		skipped := hash.FakeTransaction()
+		consensus.EXPECT().
+			GetTransactionInfo(skipped).
+			Return(&posposet.TransactionInfo{
+				Block:   expect.Block,
+				Applied: false,
+			})
+
+		got, err = client.WaitTransaction(skipped, time.Second)
+		assert.Error(err, "skipped transaction")
+		assert.Equal(expect.Block, got)
+	})
+
 	t.Run("set log level", func(t *testing.T) {
 		assert := assert.New(t)
 
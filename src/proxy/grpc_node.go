@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
@@ -12,7 +13,10 @@ import (
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
+	"github.com/Fantom-foundation/go-lachesis/src/inter/wire"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 	"github.com/Fantom-foundation/go-lachesis/src/proxy/internal"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 const (
@@ -87,9 +91,6 @@ func (p *grpcNodeProxy) StakeOf(peer hash.Peer) (uint64, error) {
 }
 
 func (p *grpcNodeProxy) SendTo(receiver hash.Peer, index, amount, until uint64) (hash.Transaction, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
-	defer cancel()
-
 	req := internal.TransferRequest{
 		Receiver: &internal.ID{
 			Hex: receiver.Hex(),
@@ -99,7 +100,59 @@ func (p *grpcNodeProxy) SendTo(receiver hash.Peer, index, amount, until uint64)
 		Until:  until,
 	}
 
-	resp, err := p.client.SendTo(ctx, &req)
+	return p.sendTransaction(&req)
+}
+
+func (p *grpcNodeProxy) Undelegate(from hash.Peer, index, amount, until uint64) (hash.Transaction, error) {
+	req := internal.TransferRequest{
+		Receiver: &internal.ID{
+			Hex: from.Hex(),
+		},
+		Nonce:  index,
+		Amount: amount,
+		Until:  until,
+		Kind:   wire.InternalTransactionKind_UNDELEGATE,
+	}
+
+	return p.sendTransaction(&req)
+}
+
+func (p *grpcNodeProxy) Redelegate(from, to hash.Peer, index, amount, until uint64) (hash.Transaction, error) {
+	req := internal.TransferRequest{
+		Receiver: &internal.ID{
+			Hex: to.Hex(),
+		},
+		Nonce:  index,
+		Amount: amount,
+		Until:  until,
+		Kind:   wire.InternalTransactionKind_REDELEGATE,
+		Source: &internal.ID{
+			Hex: from.Hex(),
+		},
+	}
+
+	return p.sendTransaction(&req)
+}
+
+func (p *grpcNodeProxy) GetDelegations(peer hash.Peer) (incoming, outgoing []*state.Delegation, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	resp, err := p.client.Delegations(ctx, &internal.ID{
+		Hex: peer.Hex(),
+	})
+	if err != nil {
+		return nil, nil, unwrapGrpcErr(err)
+	}
+
+	return wireToDelegations(resp.Incoming), wireToDelegations(resp.Outgoing), nil
+}
+
+func (p *grpcNodeProxy) sendTransaction(req *internal.TransferRequest) (hash.Transaction, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	resp, err := p.client.SendTo(ctx, req)
 	if err != nil {
 		return hash.ZeroTransaction, unwrapGrpcErr(err)
 	}
@@ -128,6 +181,36 @@ func (p *grpcNodeProxy) GetTransaction(t hash.Transaction) (*inter.InternalTrans
 	}, nil
 }
 
+func (p *grpcNodeProxy) GetBlock(n uint64) (*posposet.Block, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	req := internal.BlockRequest{
+		Index: n,
+	}
+
+	resp, err := p.client.BlockInfo(ctx, &req)
+	if err != nil {
+		return nil, unwrapGrpcErr(err)
+	}
+
+	events := make(hash.EventsSlice, len(resp.Events))
+	for i, hex := range resp.Events {
+		events[i] = hash.HexToEventHash(hex)
+	}
+
+	return &posposet.Block{
+		Index:      resp.Index,
+		Prev:       hash.HexToHash(resp.Prev),
+		Frame:      resp.Frame,
+		Atropos:    hash.HexToEventHash(resp.Atropos),
+		Time:       inter.Timestamp(resp.Time),
+		EventsRoot: hash.HexToHash(resp.EventsRoot),
+		Root:       hash.HexToHash(resp.Root),
+		Events:     events,
+	}, nil
+}
+
 func (p *grpcNodeProxy) SetLogLevel(l string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
 	defer cancel()
@@ -143,6 +226,63 @@ func (p *grpcNodeProxy) SetLogLevel(l string) error {
 	return nil
 }
 
+func (p *grpcNodeProxy) GetCheaters() ([]hash.Peer, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	resp, err := p.client.Cheaters(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, unwrapGrpcErr(err)
+	}
+
+	res := make([]hash.Peer, len(resp.Ids))
+	for i, id := range resp.Ids {
+		res[i] = hash.HexToPeer(id.Hex)
+	}
+
+	return res, nil
+}
+
+func (p *grpcNodeProxy) GetForkProof(peer hash.Peer) (*inter.ForkProof, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	req := internal.ID{
+		Hex: peer.Hex(),
+	}
+
+	resp, err := p.client.ForkProof(ctx, &req)
+	if err != nil {
+		return nil, unwrapGrpcErr(err)
+	}
+
+	return inter.WireToForkProof(resp), nil
+}
+
+func (p *grpcNodeProxy) WaitTransaction(t hash.Transaction, timeout time.Duration) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req := internal.FinalityRequest{
+		Transactions: []string{t.Hex()},
+	}
+
+	stream, err := p.client.Finality(ctx, &req)
+	if err != nil {
+		return 0, unwrapGrpcErr(err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return 0, unwrapGrpcErr(err)
+	}
+	if !resp.Applied {
+		return resp.Block, fmt.Errorf("transaction is skipped at block %d", resp.Block)
+	}
+
+	return resp.Block, nil
+}
+
 func unwrapGrpcErr(err error) error {
 	st := status.Convert(err)
 	return errors.New(st.Message())
@@ -4,6 +4,8 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/poset"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 /*
@@ -37,4 +39,10 @@ type Node interface {
 type Consensus interface {
 	StakeOf(peer hash.Peer) uint64
 	GetTransaction(hash.Transaction) *inter.InternalTransaction
+	GetCheaters() []hash.Peer
+	GetForkProof(hash.Peer) *inter.ForkProof
+	GetDelegations(hash.Peer) (incoming, outgoing []*state.Delegation)
+	GetTransactionInfo(hash.Transaction) *posposet.TransactionInfo
+	GetBlock(uint64) *posposet.Block
+	Subscribe(kinds posposet.NotificationKind, buffer int, policy posposet.SlowPolicy) *posposet.Subscription
 }
@@ -5,6 +5,7 @@ package internal
 
 import (
 	fmt "fmt"
+	wire "github.com/Fantom-foundation/go-lachesis/src/inter/wire"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	context "golang.org/x/net/context"
@@ -23,6 +24,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type FinalityNotification_Kind int32
+
+const (
+	FinalityNotification_BLOCK       FinalityNotification_Kind = 0
+	FinalityNotification_ATROPOS     FinalityNotification_Kind = 1
+	FinalityNotification_EVENT       FinalityNotification_Kind = 2
+	FinalityNotification_TRANSACTION FinalityNotification_Kind = 3
+)
+
+var FinalityNotification_Kind_name = map[int32]string{
+	0: "BLOCK",
+	1: "ATROPOS",
+	2: "EVENT",
+	3: "TRANSACTION",
+}
+
+var FinalityNotification_Kind_value = map[string]int32{
+	"BLOCK":       0,
+	"ATROPOS":     1,
+	"EVENT":       2,
+	"TRANSACTION": 3,
+}
+
+func (x FinalityNotification_Kind) String() string {
+	return proto.EnumName(FinalityNotification_Kind_name, int32(x))
+}
+
+func (FinalityNotification_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{12, 0}
+}
+
 type ID struct {
 	Hex                  string   `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -62,6 +94,45 @@ func (m *ID) GetHex() string {
 	return ""
 }
 
+type IDs struct {
+	Ids                  []*ID    `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDs) Reset()         { *m = IDs{} }
+func (m *IDs) String() string { return proto.CompactTextString(m) }
+func (*IDs) ProtoMessage()    {}
+func (*IDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{1}
+}
+
+func (m *IDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDs.Unmarshal(m, b)
+}
+func (m *IDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDs.Marshal(b, m, deterministic)
+}
+func (m *IDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDs.Merge(m, src)
+}
+func (m *IDs) XXX_Size() int {
+	return xxx_messageInfo_IDs.Size(m)
+}
+func (m *IDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDs proto.InternalMessageInfo
+
+func (m *IDs) GetIds() []*ID {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type Balance struct {
 	Amount               uint64   `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -73,7 +144,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{1}
+	return fileDescriptor_af4c68a24d38d4c7, []int{2}
 }
 
 func (m *Balance) XXX_Unmarshal(b []byte) error {
@@ -102,20 +173,22 @@ func (m *Balance) GetAmount() uint64 {
 }
 
 type TransferRequest struct {
-	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Receiver             *ID      `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Amount               uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Until                uint64   `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Nonce                uint64                       `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Receiver             *ID                          `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount               uint64                       `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Until                uint64                       `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	Kind                 wire.InternalTransactionKind `protobuf:"varint,5,opt,name=kind,proto3,enum=wire.InternalTransactionKind" json:"kind,omitempty"`
+	Source               *ID                          `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *TransferRequest) Reset()         { *m = TransferRequest{} }
 func (m *TransferRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferRequest) ProtoMessage()    {}
 func (*TransferRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{2}
+	return fileDescriptor_af4c68a24d38d4c7, []int{3}
 }
 
 func (m *TransferRequest) XXX_Unmarshal(b []byte) error {
@@ -164,6 +237,20 @@ func (m *TransferRequest) GetUntil() uint64 {
 	return 0
 }
 
+func (m *TransferRequest) GetKind() wire.InternalTransactionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return wire.InternalTransactionKind_TRANSFER
+}
+
+func (m *TransferRequest) GetSource() *ID {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
 type TransferResponse struct {
 	Hex                  string   `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -175,7 +262,7 @@ func (m *TransferResponse) Reset()         { *m = TransferResponse{} }
 func (m *TransferResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferResponse) ProtoMessage()    {}
 func (*TransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{3}
+	return fileDescriptor_af4c68a24d38d4c7, []int{4}
 }
 
 func (m *TransferResponse) XXX_Unmarshal(b []byte) error {
@@ -214,7 +301,7 @@ func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()    {}
 func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{4}
+	return fileDescriptor_af4c68a24d38d4c7, []int{5}
 }
 
 func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -256,7 +343,7 @@ func (m *TransactionResponse) Reset()         { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()    {}
 func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{5}
+	return fileDescriptor_af4c68a24d38d4c7, []int{6}
 }
 
 func (m *TransactionResponse) XXX_Unmarshal(b []byte) error {
@@ -305,6 +392,384 @@ func (m *TransactionResponse) GetUntil() uint64 {
 	return 0
 }
 
+type BlockRequest struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{7}
+}
+
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRequest.Size(m)
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Frame                uint64   `protobuf:"varint,2,opt,name=frame,proto3" json:"frame,omitempty"`
+	Atropos              string   `protobuf:"bytes,3,opt,name=atropos,proto3" json:"atropos,omitempty"`
+	Time                 uint64   `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Root                 string   `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	Events               []string `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	Prev                 string   `protobuf:"bytes,7,opt,name=prev,proto3" json:"prev,omitempty"`
+	EventsRoot           string   `protobuf:"bytes,8,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
+func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockResponse) ProtoMessage()    {}
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{8}
+}
+
+func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
+}
+func (m *BlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockResponse.Merge(m, src)
+}
+func (m *BlockResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockResponse.Size(m)
+}
+func (m *BlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockResponse proto.InternalMessageInfo
+
+func (m *BlockResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetAtropos() string {
+	if m != nil {
+		return m.Atropos
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *BlockResponse) GetPrev() string {
+	if m != nil {
+		return m.Prev
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetEventsRoot() string {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return ""
+}
+
+type Delegation struct {
+	Peer                 *ID      `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Until                uint64   `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{9}
+}
+
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delegation.Unmarshal(m, b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return xxx_messageInfo_Delegation.Size(m)
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetPeer() *ID {
+	if m != nil {
+		return m.Peer
+	}
+	return nil
+}
+
+func (m *Delegation) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Delegation) GetUntil() uint64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type DelegationsResponse struct {
+	Incoming             []*Delegation `protobuf:"bytes,1,rep,name=incoming,proto3" json:"incoming,omitempty"`
+	Outgoing             []*Delegation `protobuf:"bytes,2,rep,name=outgoing,proto3" json:"outgoing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DelegationsResponse) Reset()         { *m = DelegationsResponse{} }
+func (m *DelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationsResponse) ProtoMessage()    {}
+func (*DelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{10}
+}
+
+func (m *DelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationsResponse.Unmarshal(m, b)
+}
+func (m *DelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationsResponse.Marshal(b, m, deterministic)
+}
+func (m *DelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationsResponse.Merge(m, src)
+}
+func (m *DelegationsResponse) XXX_Size() int {
+	return xxx_messageInfo_DelegationsResponse.Size(m)
+}
+func (m *DelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationsResponse proto.InternalMessageInfo
+
+func (m *DelegationsResponse) GetIncoming() []*Delegation {
+	if m != nil {
+		return m.Incoming
+	}
+	return nil
+}
+
+func (m *DelegationsResponse) GetOutgoing() []*Delegation {
+	if m != nil {
+		return m.Outgoing
+	}
+	return nil
+}
+
+type FinalityRequest struct {
+	Blocks               bool     `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Atroposes            bool     `protobuf:"varint,2,opt,name=atroposes,proto3" json:"atroposes,omitempty"`
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Transactions         []string `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalityRequest) Reset()         { *m = FinalityRequest{} }
+func (m *FinalityRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalityRequest) ProtoMessage()    {}
+func (*FinalityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{11}
+}
+
+func (m *FinalityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityRequest.Unmarshal(m, b)
+}
+func (m *FinalityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityRequest.Merge(m, src)
+}
+func (m *FinalityRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalityRequest.Size(m)
+}
+func (m *FinalityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityRequest proto.InternalMessageInfo
+
+func (m *FinalityRequest) GetBlocks() bool {
+	if m != nil {
+		return m.Blocks
+	}
+	return false
+}
+
+func (m *FinalityRequest) GetAtroposes() bool {
+	if m != nil {
+		return m.Atroposes
+	}
+	return false
+}
+
+func (m *FinalityRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *FinalityRequest) GetTransactions() []string {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type FinalityNotification struct {
+	Kind                 FinalityNotification_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=internal.FinalityNotification_Kind" json:"kind,omitempty"`
+	Block                uint64                    `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Frame                uint64                    `protobuf:"varint,3,opt,name=frame,proto3" json:"frame,omitempty"`
+	Hex                  string                    `protobuf:"bytes,4,opt,name=hex,proto3" json:"hex,omitempty"`
+	Time                 uint64                    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Applied              bool                      `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *FinalityNotification) Reset()         { *m = FinalityNotification{} }
+func (m *FinalityNotification) String() string { return proto.CompactTextString(m) }
+func (*FinalityNotification) ProtoMessage()    {}
+func (*FinalityNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af4c68a24d38d4c7, []int{12}
+}
+
+func (m *FinalityNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityNotification.Unmarshal(m, b)
+}
+func (m *FinalityNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityNotification.Marshal(b, m, deterministic)
+}
+func (m *FinalityNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityNotification.Merge(m, src)
+}
+func (m *FinalityNotification) XXX_Size() int {
+	return xxx_messageInfo_FinalityNotification.Size(m)
+}
+func (m *FinalityNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityNotification proto.InternalMessageInfo
+
+func (m *FinalityNotification) GetKind() FinalityNotification_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return FinalityNotification_BLOCK
+}
+
+func (m *FinalityNotification) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *FinalityNotification) GetFrame() uint64 {
+	if m != nil {
+		return m.Frame
+	}
+	return 0
+}
+
+func (m *FinalityNotification) GetHex() string {
+	if m != nil {
+		return m.Hex
+	}
+	return ""
+}
+
+func (m *FinalityNotification) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *FinalityNotification) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
 type LogLevel struct {
 	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -316,7 +781,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af4c68a24d38d4c7, []int{6}
+	return fileDescriptor_af4c68a24d38d4c7, []int{13}
 }
 
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
@@ -345,43 +810,85 @@ func (m *LogLevel) GetLevel() string {
 }
 
 func init() {
+	proto.RegisterEnum("internal.FinalityNotification_Kind", FinalityNotification_Kind_name, FinalityNotification_Kind_value)
 	proto.RegisterType((*ID)(nil), "internal.ID")
+	proto.RegisterType((*IDs)(nil), "internal.IDs")
 	proto.RegisterType((*Balance)(nil), "internal.Balance")
 	proto.RegisterType((*TransferRequest)(nil), "internal.TransferRequest")
 	proto.RegisterType((*TransferResponse)(nil), "internal.TransferResponse")
 	proto.RegisterType((*TransactionRequest)(nil), "internal.TransactionRequest")
 	proto.RegisterType((*TransactionResponse)(nil), "internal.TransactionResponse")
+	proto.RegisterType((*BlockRequest)(nil), "internal.BlockRequest")
+	proto.RegisterType((*BlockResponse)(nil), "internal.BlockResponse")
+	proto.RegisterType((*Delegation)(nil), "internal.Delegation")
+	proto.RegisterType((*DelegationsResponse)(nil), "internal.DelegationsResponse")
+	proto.RegisterType((*FinalityRequest)(nil), "internal.FinalityRequest")
+	proto.RegisterType((*FinalityNotification)(nil), "internal.FinalityNotification")
 	proto.RegisterType((*LogLevel)(nil), "internal.LogLevel")
 }
 
 func init() { proto.RegisterFile("internal/ctrl.proto", fileDescriptor_af4c68a24d38d4c7) }
 
 var fileDescriptor_af4c68a24d38d4c7 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x4d, 0x6f, 0xda, 0x40,
-	0x14, 0xb4, 0x81, 0x02, 0x7d, 0x54, 0x2a, 0x7d, 0x54, 0x88, 0xba, 0xad, 0x44, 0x57, 0x55, 0xc4,
-	0x21, 0x32, 0x11, 0x39, 0xe6, 0x94, 0x88, 0x1c, 0x2c, 0xa1, 0x24, 0x32, 0xfc, 0x01, 0x63, 0x9e,
-	0x89, 0x95, 0x65, 0x97, 0xd8, 0x6b, 0x94, 0x9c, 0x72, 0xc8, 0x9f, 0xca, 0xcf, 0x8b, 0xfc, 0x85,
-	0x31, 0x81, 0x6b, 0x6e, 0x3b, 0x6f, 0xc6, 0xb3, 0xb3, 0x6f, 0x0c, 0x1d, 0x5f, 0x28, 0x0a, 0x84,
-	0xc3, 0x87, 0xae, 0x0a, 0xb8, 0xb9, 0x0e, 0xa4, 0x92, 0xd8, 0xcc, 0x87, 0xc6, 0xef, 0xa5, 0x94,
-	0x4b, 0x4e, 0xc3, 0x64, 0x3e, 0x8f, 0xbc, 0x21, 0xad, 0xd6, 0xea, 0x39, 0x95, 0xb1, 0x2e, 0x54,
-	0xac, 0x31, 0xb6, 0xa1, 0x7a, 0x4f, 0x4f, 0x3d, 0xbd, 0xaf, 0x0f, 0xbe, 0xda, 0xf1, 0x91, 0xfd,
-	0x83, 0xc6, 0x95, 0xc3, 0x1d, 0xe1, 0x12, 0x76, 0xa1, 0xee, 0xac, 0x64, 0x24, 0x54, 0xc2, 0xd7,
-	0xec, 0x0c, 0xb1, 0x17, 0xf8, 0x3e, 0x0b, 0x1c, 0x11, 0x7a, 0x14, 0xd8, 0xf4, 0x18, 0x51, 0xa8,
-	0xf0, 0x27, 0x7c, 0x11, 0x52, 0xb8, 0x94, 0x29, 0x53, 0x80, 0x03, 0x68, 0x06, 0xe4, 0x92, 0xbf,
-	0xa1, 0xa0, 0x57, 0xe9, 0xeb, 0x83, 0xd6, 0xe8, 0x9b, 0x99, 0xa7, 0x33, 0xad, 0xb1, 0xbd, 0x65,
-	0x77, 0xae, 0xaa, 0xee, 0x5e, 0x15, 0xfb, 0x46, 0x42, 0xf9, 0xbc, 0x57, 0x4b, 0x7d, 0x13, 0xc0,
-	0xfe, 0x43, 0xbb, 0x08, 0x10, 0xae, 0xa5, 0x08, 0xe9, 0xc0, 0x4b, 0x4e, 0x00, 0x13, 0x95, 0xe3,
-	0x2a, 0x5f, 0x8a, 0x3c, 0xe9, 0x47, 0xdd, 0xab, 0x0e, 0x9d, 0x92, 0x30, 0x73, 0xfc, 0xdc, 0x37,
-	0xf5, 0xa1, 0x39, 0x91, 0xcb, 0x09, 0x6d, 0x88, 0xc7, 0x0a, 0x1e, 0x1f, 0xb2, 0x94, 0x29, 0x18,
-	0xbd, 0x55, 0xa0, 0x76, 0x23, 0x17, 0x84, 0x67, 0x50, 0x9f, 0x12, 0xf7, 0xac, 0x31, 0x76, 0xcd,
-	0xb4, 0x62, 0x33, 0xaf, 0xd8, 0xbc, 0x8e, 0x2b, 0x36, 0x4a, 0x91, 0x98, 0x86, 0xa7, 0xd0, 0x98,
-	0x2a, 0xe7, 0x81, 0x6e, 0x3d, 0x2c, 0x51, 0xc6, 0x8f, 0x02, 0x65, 0xad, 0x33, 0x0d, 0x2f, 0x63,
-	0x7f, 0xb1, 0x98, 0x49, 0xfc, 0x55, 0xd0, 0x7b, 0x8d, 0x1b, 0xc6, 0x21, 0x2a, 0xdd, 0x1c, 0xd3,
-	0xf0, 0x2e, 0xfb, 0x45, 0xd2, 0x95, 0x5a, 0xc2, 0x93, 0xf8, 0x67, 0xef, 0x83, 0x52, 0x2d, 0xc6,
-	0xdf, 0x23, 0xec, 0xd6, 0xf1, 0x02, 0x5a, 0x53, 0x52, 0xdb, 0x15, 0x61, 0xa1, 0xcf, 0x67, 0xc6,
-	0x91, 0x6d, 0x30, 0x6d, 0x5e, 0x4f, 0x26, 0xe7, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa5,
-	0xfc, 0x53, 0x31, 0x03, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x51, 0x6f, 0xe3, 0x44,
+	0x10, 0x8e, 0x63, 0x27, 0x71, 0x26, 0x77, 0x34, 0x6c, 0xab, 0x60, 0xcc, 0xf5, 0x08, 0x4b, 0x41,
+	0x79, 0xe0, 0x9c, 0xa3, 0xf7, 0x80, 0x04, 0x08, 0xa9, 0x6d, 0x5a, 0x29, 0xba, 0x2a, 0xa9, 0x9c,
+	0x88, 0x27, 0x24, 0xe4, 0x3a, 0x9b, 0x74, 0x55, 0x67, 0x37, 0xec, 0x6e, 0x0a, 0x7d, 0xe6, 0xdf,
+	0xf1, 0x0b, 0xf8, 0x01, 0xfc, 0x07, 0x5e, 0xd1, 0xae, 0xed, 0xd8, 0x49, 0xd3, 0x7b, 0xe4, 0xcd,
+	0x33, 0xf3, 0xed, 0xec, 0xec, 0x37, 0xf3, 0x8d, 0xe1, 0x90, 0x32, 0x45, 0x04, 0x8b, 0x92, 0x7e,
+	0xac, 0x44, 0x12, 0xac, 0x04, 0x57, 0x1c, 0xb9, 0xb9, 0xd3, 0xff, 0x6c, 0xc1, 0xf9, 0x22, 0x21,
+	0x7d, 0xe3, 0xbf, 0x5d, 0xcf, 0xfb, 0x64, 0xb9, 0x52, 0x8f, 0x29, 0xcc, 0xbf, 0x58, 0x50, 0x75,
+	0xb7, 0xbe, 0x0d, 0x62, 0xbe, 0xec, 0x5f, 0x45, 0x4c, 0xf1, 0xe5, 0x9b, 0x39, 0x5f, 0xb3, 0x59,
+	0xa4, 0x28, 0x67, 0xfd, 0x05, 0x7f, 0x93, 0x44, 0xf1, 0x1d, 0x91, 0x54, 0xf6, 0xa5, 0x88, 0xfb,
+	0x26, 0x67, 0xff, 0x77, 0x2a, 0x48, 0x9f, 0x3c, 0x10, 0xa6, 0xd2, 0x24, 0xb8, 0x03, 0xd5, 0xe1,
+	0x00, 0xb5, 0xc1, 0xbe, 0x23, 0x7f, 0x78, 0x56, 0xd7, 0xea, 0x35, 0x43, 0xfd, 0x89, 0xbf, 0x02,
+	0x7b, 0x38, 0x90, 0xe8, 0x35, 0xd8, 0x74, 0x26, 0x3d, 0xab, 0x6b, 0xf7, 0x5a, 0xa7, 0x2f, 0x82,
+	0xbc, 0xb0, 0x60, 0x38, 0x08, 0x75, 0x00, 0x7f, 0x01, 0x8d, 0xf3, 0x28, 0x89, 0x58, 0x4c, 0x50,
+	0x07, 0xea, 0xd1, 0x92, 0xaf, 0x99, 0x32, 0x69, 0x9c, 0x30, 0xb3, 0xf0, 0xdf, 0x16, 0x1c, 0x4c,
+	0x45, 0xc4, 0xe4, 0x9c, 0x88, 0x90, 0xfc, 0xb6, 0x26, 0x52, 0xa1, 0x23, 0xa8, 0x31, 0xce, 0x62,
+	0x92, 0x41, 0x53, 0x03, 0xf5, 0xc0, 0x15, 0x24, 0x26, 0xf4, 0x81, 0x08, 0xaf, 0xda, 0xb5, 0x9e,
+	0xdc, 0xb8, 0x89, 0x96, 0xee, 0xb2, 0xcb, 0x77, 0xe9, 0xbc, 0x6b, 0xa6, 0x68, 0xe2, 0x39, 0x69,
+	0x5e, 0x63, 0xa0, 0x6f, 0xc1, 0xb9, 0xa7, 0x6c, 0xe6, 0xd5, 0xba, 0x56, 0xef, 0xa3, 0xd3, 0xe3,
+	0x40, 0x93, 0x10, 0x0c, 0xb3, 0xc4, 0xa6, 0xb4, 0x28, 0xd6, 0x9c, 0xbd, 0xa7, 0x6c, 0x16, 0x1a,
+	0x28, 0x3a, 0x81, 0xba, 0xe4, 0x6b, 0x11, 0x13, 0xaf, 0xbe, 0xa7, 0x90, 0x2c, 0x86, 0x4f, 0xa0,
+	0x5d, 0xbc, 0x4c, 0xae, 0x38, 0x93, 0x64, 0x0f, 0x95, 0x5f, 0x03, 0x2a, 0x5d, 0x92, 0x53, 0xf0,
+	0x14, 0xf7, 0xa7, 0x05, 0x87, 0x5b, 0xc0, 0x2c, 0xe3, 0xff, 0x4a, 0x16, 0x3e, 0x81, 0x17, 0xe7,
+	0x09, 0x8f, 0xef, 0x4b, 0xad, 0xa2, 0x6c, 0x96, 0x55, 0xea, 0x84, 0xa9, 0x81, 0xff, 0xb2, 0xe0,
+	0x65, 0x06, 0x2b, 0xaa, 0x7c, 0x8a, 0xd3, 0xde, 0xb9, 0x88, 0x96, 0xc4, 0x94, 0xe8, 0x84, 0xa9,
+	0x81, 0x3c, 0x68, 0x44, 0x4a, 0xf0, 0x15, 0x97, 0xa6, 0xa4, 0x66, 0x98, 0x9b, 0x08, 0x81, 0xa3,
+	0xe8, 0x92, 0x64, 0x25, 0x99, 0x6f, 0xed, 0x13, 0x9c, 0x2b, 0xd3, 0xbe, 0x66, 0x68, 0xbe, 0xf5,
+	0x9b, 0xcc, 0x14, 0x4b, 0xaf, 0xde, 0xb5, 0x7b, 0xcd, 0x30, 0xb3, 0x34, 0x76, 0x25, 0xc8, 0x83,
+	0xd7, 0x48, 0xb1, 0xfa, 0x1b, 0x7d, 0x0e, 0xad, 0x34, 0xfa, 0xab, 0x49, 0xe3, 0x9a, 0x10, 0xa4,
+	0xae, 0x90, 0x73, 0x85, 0x7f, 0x01, 0x18, 0x90, 0x84, 0x2c, 0x8c, 0x70, 0x50, 0x17, 0x9c, 0x15,
+	0x21, 0xc2, 0xb3, 0xf6, 0x90, 0x6a, 0x22, 0x25, 0x42, 0xab, 0xfb, 0x09, 0xb5, 0xcb, 0x84, 0x3e,
+	0xc2, 0x61, 0x91, 0x5d, 0x6e, 0xf8, 0x7a, 0x0b, 0x2e, 0x65, 0x31, 0x5f, 0x52, 0xb6, 0xc8, 0xe4,
+	0x75, 0x54, 0x5c, 0x55, 0x1c, 0x08, 0x37, 0x28, 0x7d, 0x82, 0xaf, 0xd5, 0x82, 0xeb, 0x13, 0xd5,
+	0x0f, 0x9d, 0xc8, 0x51, 0x7a, 0xa2, 0x0e, 0xae, 0x28, 0x8b, 0x12, 0xaa, 0x1e, 0xf3, 0x7e, 0x76,
+	0xa0, 0x7e, 0xab, 0x1b, 0x27, 0xcd, 0x03, 0xdd, 0x30, 0xb3, 0xd0, 0x2b, 0x68, 0x66, 0x4d, 0x20,
+	0xd2, 0xbc, 0xcb, 0x0d, 0x0b, 0x47, 0x89, 0x6f, 0x7b, 0x8b, 0x6f, 0x0c, 0x2f, 0x54, 0x31, 0xb2,
+	0xd2, 0x73, 0x4c, 0x74, 0xcb, 0x87, 0xff, 0xb5, 0xe0, 0x28, 0xaf, 0x62, 0xc4, 0x15, 0x9d, 0xd3,
+	0x38, 0x65, 0xfa, 0xbb, 0x4c, 0x97, 0x96, 0xd1, 0xe5, 0x97, 0xc5, 0x63, 0xf6, 0xa1, 0x83, 0x92,
+	0x3a, 0x8f, 0xa0, 0x66, 0xaa, 0xce, 0xa7, 0xca, 0x18, 0xc5, 0xac, 0xd9, 0xe5, 0x59, 0xcb, 0x74,
+	0xe6, 0x6c, 0x74, 0xb6, 0x99, 0xb1, 0x5a, 0x69, 0xc6, 0xf4, 0x44, 0xae, 0x56, 0x09, 0x25, 0x33,
+	0x23, 0x78, 0x37, 0xcc, 0x4d, 0xfc, 0x3d, 0x38, 0xfa, 0x66, 0xd4, 0x84, 0xda, 0xf9, 0xf5, 0xf8,
+	0xe2, 0x7d, 0xbb, 0x82, 0x5a, 0xd0, 0x38, 0x9b, 0x86, 0xe3, 0x9b, 0xf1, 0xa4, 0x6d, 0x69, 0xff,
+	0xe5, 0xcf, 0x97, 0xa3, 0x69, 0xbb, 0x8a, 0x0e, 0xa0, 0x35, 0x0d, 0xcf, 0x46, 0x93, 0xb3, 0x8b,
+	0xe9, 0x70, 0x3c, 0x6a, 0xdb, 0xb8, 0x0b, 0xee, 0x35, 0x5f, 0x5c, 0x93, 0x07, 0x92, 0xe8, 0xea,
+	0x12, 0xfd, 0x91, 0x29, 0x3e, 0x35, 0x4e, 0xff, 0x71, 0xc0, 0x19, 0xf1, 0x99, 0x1e, 0x87, 0xfa,
+	0x84, 0x24, 0xf3, 0xe1, 0x00, 0x75, 0x82, 0x74, 0xe9, 0x07, 0xf9, 0xd2, 0x0f, 0x2e, 0xf5, 0xd2,
+	0xf7, 0xb7, 0x26, 0x11, 0x57, 0xd0, 0x37, 0xd0, 0x98, 0xa8, 0xe8, 0x9e, 0x8c, 0xe7, 0x68, 0x2b,
+	0xe4, 0x7f, 0x5c, 0x58, 0xd9, 0x6e, 0xc6, 0x15, 0x74, 0xa6, 0xf3, 0xb3, 0xd9, 0x94, 0xa3, 0x4f,
+	0x8b, 0xf0, 0xce, 0x5a, 0xf6, 0xfd, 0x7d, 0xa1, 0x74, 0x5e, 0x71, 0x05, 0xdd, 0x64, 0x7b, 0x3c,
+	0xed, 0xeb, 0x90, 0xcd, 0x39, 0x7a, 0xb5, 0x73, 0x60, 0x6b, 0xc5, 0xf9, 0xc7, 0xcf, 0x44, 0x37,
+	0x19, 0x7f, 0x82, 0xa6, 0x59, 0x22, 0x26, 0x57, 0xa7, 0x54, 0x76, 0x69, 0x01, 0xf9, 0x9f, 0x3c,
+	0xf1, 0x6f, 0xce, 0xff, 0x00, 0xad, 0x09, 0x51, 0x1b, 0x8a, 0x51, 0x81, 0xcc, 0x7d, 0xfe, 0x33,
+	0x6c, 0xe2, 0x0a, 0x7a, 0x07, 0xee, 0xc5, 0x1d, 0x89, 0x14, 0x11, 0xf2, 0x59, 0xce, 0x5f, 0x96,
+	0x89, 0x95, 0x86, 0xf4, 0xe6, 0x15, 0x17, 0xf7, 0x37, 0x82, 0xf3, 0x5d, 0xda, 0x0f, 0xd2, 0xff,
+	0xca, 0x26, 0x8c, 0x2b, 0xe8, 0x47, 0x68, 0x95, 0xa4, 0xbf, 0x83, 0x3f, 0xde, 0x27, 0x5e, 0x59,
+	0x7a, 0xdd, 0x10, 0xdc, 0x5c, 0x08, 0xe5, 0xa6, 0xed, 0x08, 0xda, 0x7f, 0xfd, 0x61, 0xdd, 0xe0,
+	0xca, 0x5b, 0xeb, 0xb6, 0x6e, 0xde, 0xf5, 0xee, 0xbf, 0x01, 0x00, 0x36, 0x71, 0x7b, 0x62, 0x6f,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -400,7 +907,12 @@ type NodeClient interface {
 	StakeOf(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Balance, error)
 	SendTo(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
 	TransactionInfo(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	BlockInfo(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*empty.Empty, error)
+	Cheaters(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IDs, error)
+	ForkProof(ctx context.Context, in *ID, opts ...grpc.CallOption) (*wire.ForkProof, error)
+	Delegations(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DelegationsResponse, error)
+	Finality(ctx context.Context, in *FinalityRequest, opts ...grpc.CallOption) (Node_FinalityClient, error)
 }
 
 type nodeClient struct {
@@ -447,6 +959,15 @@ func (c *nodeClient) TransactionInfo(ctx context.Context, in *TransactionRequest
 	return out, nil
 }
 
+func (c *nodeClient) BlockInfo(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/internal.Node/BlockInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/internal.Node/SetLogLevel", in, out, opts...)
@@ -456,13 +977,77 @@ func (c *nodeClient) SetLogLevel(ctx context.Context, in *LogLevel, opts ...grpc
 	return out, nil
 }
 
+func (c *nodeClient) Cheaters(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*IDs, error) {
+	out := new(IDs)
+	err := c.cc.Invoke(ctx, "/internal.Node/Cheaters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) ForkProof(ctx context.Context, in *ID, opts ...grpc.CallOption) (*wire.ForkProof, error) {
+	out := new(wire.ForkProof)
+	err := c.cc.Invoke(ctx, "/internal.Node/ForkProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Delegations(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DelegationsResponse, error) {
+	out := new(DelegationsResponse)
+	err := c.cc.Invoke(ctx, "/internal.Node/Delegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Finality(ctx context.Context, in *FinalityRequest, opts ...grpc.CallOption) (Node_FinalityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Node_serviceDesc.Streams[0], "/internal.Node/Finality", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nodeFinalityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Node_FinalityClient interface {
+	Recv() (*FinalityNotification, error)
+	grpc.ClientStream
+}
+
+type nodeFinalityClient struct {
+	grpc.ClientStream
+}
+
+func (x *nodeFinalityClient) Recv() (*FinalityNotification, error) {
+	m := new(FinalityNotification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	SelfID(context.Context, *empty.Empty) (*ID, error)
 	StakeOf(context.Context, *ID) (*Balance, error)
 	SendTo(context.Context, *TransferRequest) (*TransferResponse, error)
 	TransactionInfo(context.Context, *TransactionRequest) (*TransactionResponse, error)
+	BlockInfo(context.Context, *BlockRequest) (*BlockResponse, error)
 	SetLogLevel(context.Context, *LogLevel) (*empty.Empty, error)
+	Cheaters(context.Context, *empty.Empty) (*IDs, error)
+	ForkProof(context.Context, *ID) (*wire.ForkProof, error)
+	Delegations(context.Context, *ID) (*DelegationsResponse, error)
+	Finality(*FinalityRequest, Node_FinalityServer) error
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -541,6 +1126,24 @@ func _Node_TransactionInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_BlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).BlockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/internal.Node/BlockInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).BlockInfo(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Node_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogLevel)
 	if err := dec(in); err != nil {
@@ -559,6 +1162,81 @@ func _Node_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_Cheaters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Cheaters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/internal.Node/Cheaters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Cheaters(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_ForkProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ForkProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/internal.Node/ForkProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ForkProof(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Delegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Delegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/internal.Node/Delegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Delegations(ctx, req.(*ID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Finality_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FinalityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).Finality(m, &nodeFinalityServer{stream})
+}
+
+type Node_FinalityServer interface {
+	Send(*FinalityNotification) error
+	grpc.ServerStream
+}
+
+type nodeFinalityServer struct {
+	grpc.ServerStream
+}
+
+func (x *nodeFinalityServer) Send(m *FinalityNotification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "internal.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -579,11 +1257,33 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TransactionInfo",
 			Handler:    _Node_TransactionInfo_Handler,
 		},
+		{
+			MethodName: "BlockInfo",
+			Handler:    _Node_BlockInfo_Handler,
+		},
 		{
 			MethodName: "SetLogLevel",
 			Handler:    _Node_SetLogLevel_Handler,
 		},
+		{
+			MethodName: "Cheaters",
+			Handler:    _Node_Cheaters_Handler,
+		},
+		{
+			MethodName: "ForkProof",
+			Handler:    _Node_ForkProof_Handler,
+		},
+		{
+			MethodName: "Delegations",
+			Handler:    _Node_Delegations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Finality",
+			Handler:       _Node_Finality_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/ctrl.proto",
 }
@@ -8,6 +8,8 @@ import (
 	hash "github.com/Fantom-foundation/go-lachesis/src/hash"
 	inter "github.com/Fantom-foundation/go-lachesis/src/inter"
 	poset "github.com/Fantom-foundation/go-lachesis/src/poset"
+	posposet "github.com/Fantom-foundation/go-lachesis/src/posposet"
+	state "github.com/Fantom-foundation/go-lachesis/src/state"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -182,3 +184,88 @@ func (mr *MockConsensusMockRecorder) GetTransaction(arg0 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockConsensus)(nil).GetTransaction), arg0)
 }
+
+// GetCheaters mocks base method
+func (m *MockConsensus) GetCheaters() []hash.Peer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheaters")
+	ret0, _ := ret[0].([]hash.Peer)
+	return ret0
+}
+
+// GetCheaters indicates an expected call of GetCheaters
+func (mr *MockConsensusMockRecorder) GetCheaters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheaters", reflect.TypeOf((*MockConsensus)(nil).GetCheaters))
+}
+
+// GetForkProof mocks base method
+func (m *MockConsensus) GetForkProof(arg0 hash.Peer) *inter.ForkProof {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForkProof", arg0)
+	ret0, _ := ret[0].(*inter.ForkProof)
+	return ret0
+}
+
+// GetForkProof indicates an expected call of GetForkProof
+func (mr *MockConsensusMockRecorder) GetForkProof(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForkProof", reflect.TypeOf((*MockConsensus)(nil).GetForkProof), arg0)
+}
+
+// GetDelegations mocks base method
+func (m *MockConsensus) GetDelegations(arg0 hash.Peer) ([]*state.Delegation, []*state.Delegation) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelegations", arg0)
+	ret0, _ := ret[0].([]*state.Delegation)
+	ret1, _ := ret[1].([]*state.Delegation)
+	return ret0, ret1
+}
+
+// GetDelegations indicates an expected call of GetDelegations
+func (mr *MockConsensusMockRecorder) GetDelegations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegations", reflect.TypeOf((*MockConsensus)(nil).GetDelegations), arg0)
+}
+
+// GetTransactionInfo mocks base method
+func (m *MockConsensus) GetTransactionInfo(arg0 hash.Transaction) *posposet.TransactionInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionInfo", arg0)
+	ret0, _ := ret[0].(*posposet.TransactionInfo)
+	return ret0
+}
+
+// GetTransactionInfo indicates an expected call of GetTransactionInfo
+func (mr *MockConsensusMockRecorder) GetTransactionInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionInfo", reflect.TypeOf((*MockConsensus)(nil).GetTransactionInfo), arg0)
+}
+
+// GetBlock mocks base method
+func (m *MockConsensus) GetBlock(arg0 uint64) *posposet.Block {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlock", arg0)
+	ret0, _ := ret[0].(*posposet.Block)
+	return ret0
+}
+
+// GetBlock indicates an expected call of GetBlock
+func (mr *MockConsensusMockRecorder) GetBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlock", reflect.TypeOf((*MockConsensus)(nil).GetBlock), arg0)
+}
+
+// Subscribe mocks base method
+func (m *MockConsensus) Subscribe(kinds posposet.NotificationKind, buffer int, policy posposet.SlowPolicy) *posposet.Subscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", kinds, buffer, policy)
+	ret0, _ := ret[0].(*posposet.Subscription)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe
+func (mr *MockConsensusMockRecorder) Subscribe(kinds, buffer, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockConsensus)(nil).Subscribe), kinds, buffer, policy)
+}
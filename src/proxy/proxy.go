@@ -22,7 +22,9 @@ import (
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 	"github.com/Fantom-foundation/go-lachesis/src/inter"
 	"github.com/Fantom-foundation/go-lachesis/src/poset"
+	"github.com/Fantom-foundation/go-lachesis/src/posposet"
 	"github.com/Fantom-foundation/go-lachesis/src/proxy/proto"
+	"github.com/Fantom-foundation/go-lachesis/src/state"
 )
 
 const (
@@ -58,10 +60,25 @@ type NodeProxy interface {
 	StakeOf(hash.Peer) (uint64, error)
 	// SendTo makes stake transfer transaction.
 	SendTo(receiver hash.Peer, index, amount, until uint64) (hash.Transaction, error)
+	// Undelegate cancels delegation to peer until block.
+	Undelegate(from hash.Peer, index, amount, until uint64) (hash.Transaction, error)
+	// Redelegate moves delegation until block from one peer to another.
+	Redelegate(from, to hash.Peer, index, amount, until uint64) (hash.Transaction, error)
+	// GetDelegations returns incoming and outgoing delegations of peer.
+	GetDelegations(hash.Peer) (incoming, outgoing []*state.Delegation, err error)
 	// GetTransaction returns information about transaction.
 	GetTransaction(hash.Transaction) (*inter.InternalTransaction, error)
+	// GetBlock returns information about block.
+	GetBlock(uint64) (*posposet.Block, error)
 	// SetLogLevel sets logger log level.
 	SetLogLevel(string) error
+	// GetCheaters returns peers caught on forks.
+	GetCheaters() ([]hash.Peer, error)
+	// GetForkProof returns cheating evidence of peer.
+	GetForkProof(hash.Peer) (*inter.ForkProof, error)
+	// WaitTransaction waits for transaction finality and returns its block.
+	// Error is returned if transaction is skipped as invalid.
+	WaitTransaction(hash.Transaction, time.Duration) (uint64, error)
 	// Close stops proxy.
 	Close()
 }
@@ -59,6 +59,69 @@ func (m *Borrow) GetRecs() map[uint64]uint64 {
 	return nil
 }
 
+type Slash struct {
+	Block                uint64   `protobuf:"varint,1,opt,name=Block,proto3" json:"Block,omitempty"`
+	Offender             string   `protobuf:"bytes,2,opt,name=Offender,proto3" json:"Offender,omitempty"`
+	Amount               uint64   `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	ForkIndex            uint64   `protobuf:"varint,4,opt,name=ForkIndex,proto3" json:"ForkIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Slash) Reset()         { *m = Slash{} }
+func (m *Slash) String() string { return proto.CompactTextString(m) }
+func (*Slash) ProtoMessage()    {}
+func (*Slash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{1}
+}
+
+func (m *Slash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Slash.Unmarshal(m, b)
+}
+func (m *Slash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Slash.Marshal(b, m, deterministic)
+}
+func (m *Slash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slash.Merge(m, src)
+}
+func (m *Slash) XXX_Size() int {
+	return xxx_messageInfo_Slash.Size(m)
+}
+func (m *Slash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slash proto.InternalMessageInfo
+
+func (m *Slash) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *Slash) GetOffender() string {
+	if m != nil {
+		return m.Offender
+	}
+	return ""
+}
+
+func (m *Slash) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Slash) GetForkIndex() uint64 {
+	if m != nil {
+		return m.ForkIndex
+	}
+	return 0
+}
+
 type Account struct {
 	Balance              uint64             `protobuf:"varint,1,opt,name=Balance,proto3" json:"Balance,omitempty"`
 	RawRoot              []byte             `protobuf:"bytes,2,opt,name=RawRoot,proto3" json:"RawRoot,omitempty"`
@@ -66,6 +129,9 @@ type Account struct {
 	DelegatingFrom       map[string]*Borrow `protobuf:"bytes,4,rep,name=DelegatingFrom,proto3" json:"DelegatingFrom,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	DelegatedTo          uint64             `protobuf:"varint,5,opt,name=DelegatedTo,proto3" json:"DelegatedTo,omitempty"`
 	DelegatingTo         map[string]*Borrow `protobuf:"bytes,6,rep,name=DelegatingTo,proto3" json:"DelegatingTo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Slashes              []*Slash           `protobuf:"bytes,7,rep,name=Slashes,proto3" json:"Slashes,omitempty"`
+	Nonce                uint64             `protobuf:"varint,8,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	ValidatorKey         []byte             `protobuf:"bytes,9,opt,name=ValidatorKey,proto3" json:"ValidatorKey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -75,7 +141,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{1}
+	return fileDescriptor_8e28828dcb8d24f0, []int{2}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -138,9 +204,31 @@ func (m *Account) GetDelegatingTo() map[string]*Borrow {
 	return nil
 }
 
+func (m *Account) GetSlashes() []*Slash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Account) GetValidatorKey() []byte {
+	if m != nil {
+		return m.ValidatorKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Borrow)(nil), "state.Borrow")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "state.Borrow.RecsEntry")
+	proto.RegisterType((*Slash)(nil), "state.Slash")
 	proto.RegisterType((*Account)(nil), "state.Account")
 	proto.RegisterMapType((map[string]*Borrow)(nil), "state.Account.DelegatingFromEntry")
 	proto.RegisterMapType((map[string]*Borrow)(nil), "state.Account.DelegatingToEntry")
@@ -149,23 +237,30 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0x25, 0xcd, 0x47, 0xe9, 0xa4, 0x11, 0x5d, 0x05, 0x97, 0x9c, 0x42, 0xf4, 0x50, 0x10, 0x72,
-	0xa8, 0x07, 0xc5, 0x9b, 0xa5, 0x7a, 0xf0, 0x20, 0xb2, 0xe4, 0x0f, 0xac, 0x71, 0x29, 0x62, 0xdc,
-	0x91, 0xed, 0xd6, 0xd2, 0x9f, 0xe6, 0xbf, 0x93, 0xcc, 0xa6, 0x35, 0xf1, 0xe3, 0xe4, 0x2d, 0xf3,
-	0xde, 0xbc, 0x79, 0x33, 0x2f, 0x0b, 0x89, 0xac, 0x2a, 0x5c, 0x69, 0x5b, 0xbc, 0x19, 0xb4, 0xc8,
-	0xc2, 0xa5, 0x95, 0x56, 0xe5, 0x1a, 0xa2, 0x19, 0x1a, 0x83, 0x6b, 0x76, 0x06, 0x81, 0x50, 0xd5,
-	0x92, 0x7b, 0x99, 0x3f, 0x89, 0xa7, 0xc7, 0x05, 0xf1, 0x85, 0x23, 0x8b, 0x86, 0xb9, 0xd1, 0xd6,
-	0x6c, 0x04, 0x35, 0xa5, 0x17, 0x30, 0xda, 0x41, 0x6c, 0x1f, 0xfc, 0x17, 0xb5, 0xe1, 0x5e, 0xe6,
-	0x4d, 0x02, 0xd1, 0x7c, 0xb2, 0x23, 0x08, 0xdf, 0x65, 0xbd, 0x52, 0x7c, 0x40, 0x98, 0x2b, 0xae,
-	0x06, 0x97, 0x5e, 0xfe, 0xe1, 0xc3, 0xf0, 0xda, 0x2d, 0xc2, 0x38, 0x0c, 0x67, 0xb2, 0x96, 0xba,
-	0x52, 0xad, 0x76, 0x5b, 0x36, 0x8c, 0x90, 0x6b, 0x81, 0x68, 0x69, 0xc2, 0x58, 0x6c, 0x4b, 0x76,
-	0x0a, 0xc9, 0x5c, 0xd5, 0x6a, 0x21, 0xad, 0x7a, 0xba, 0x35, 0xf8, 0xca, 0x7d, 0x52, 0xf6, 0x41,
-	0x76, 0x07, 0x7b, 0x2d, 0xf0, 0xac, 0x17, 0xd4, 0x16, 0xd0, 0x55, 0x79, 0x7b, 0x55, 0xbb, 0x41,
-	0xd1, 0x6f, 0x72, 0x07, 0x7e, 0x53, 0xb2, 0x0c, 0xe2, 0xdd, 0xf0, 0x12, 0x79, 0x48, 0x7e, 0x5d,
-	0x88, 0xcd, 0x61, 0xfc, 0xa5, 0x29, 0x91, 0x47, 0xe4, 0x95, 0xfd, 0xe9, 0x55, 0xa2, 0x73, 0xea,
-	0xa9, 0xd2, 0x07, 0x38, 0xfc, 0x65, 0x9d, 0x6e, 0xb8, 0x23, 0x17, 0xee, 0x49, 0x37, 0xdc, 0x78,
-	0x9a, 0xf4, 0xfe, 0x54, 0x27, 0xeb, 0xf4, 0x1e, 0x0e, 0x7e, 0x98, 0xfe, 0x63, 0xde, 0x63, 0x44,
-	0x2f, 0xe7, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xf1, 0x3b, 0x5d, 0x4a, 0x02, 0x00, 0x00,
+	// 390 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0xab, 0xd3, 0x40,
+	0x10, 0x25, 0xb7, 0xf9, 0xb8, 0x99, 0xa6, 0xa2, 0xab, 0xe8, 0x12, 0x7c, 0x08, 0x51, 0xe4, 0x82,
+	0x90, 0x87, 0xeb, 0x83, 0xe2, 0xdb, 0x2d, 0xd7, 0x82, 0x0a, 0x55, 0xd6, 0xe2, 0xfb, 0x9a, 0x6c,
+	0x6b, 0x69, 0x9a, 0x91, 0xcd, 0xd6, 0xda, 0xdf, 0xe2, 0x9f, 0x95, 0xcc, 0x6e, 0xdb, 0xc4, 0x8f,
+	0x27, 0xdf, 0x7a, 0xce, 0x99, 0x33, 0xa7, 0x33, 0x3b, 0x81, 0x89, 0x2c, 0x4b, 0xdc, 0x35, 0xa6,
+	0xf8, 0xa6, 0xd1, 0x20, 0x0b, 0x5a, 0x23, 0x8d, 0xca, 0x1b, 0x08, 0xa7, 0xa8, 0x35, 0xee, 0xd9,
+	0x73, 0xf0, 0x85, 0x2a, 0x5b, 0xee, 0x65, 0xa3, 0xab, 0xf1, 0xf5, 0xa3, 0x82, 0xf4, 0xc2, 0x8a,
+	0x45, 0xa7, 0xbc, 0x69, 0x8c, 0x3e, 0x08, 0x2a, 0x4a, 0x5f, 0x42, 0x7c, 0xa2, 0xd8, 0x5d, 0x18,
+	0x6d, 0xd4, 0x81, 0x7b, 0x99, 0x77, 0xe5, 0x8b, 0xee, 0x27, 0x7b, 0x00, 0xc1, 0x77, 0x59, 0xef,
+	0x14, 0xbf, 0x20, 0xce, 0x82, 0xd7, 0x17, 0xaf, 0xbc, 0x1c, 0x21, 0xf8, 0x54, 0xcb, 0xf6, 0x6b,
+	0x57, 0x32, 0xad, 0xb1, 0xdc, 0x38, 0x9b, 0x05, 0x2c, 0x85, 0xcb, 0x0f, 0xcb, 0xa5, 0x6a, 0x2a,
+	0xa5, 0xc9, 0x1b, 0x8b, 0x13, 0x66, 0x0f, 0x21, 0xbc, 0xd9, 0x76, 0x13, 0xf0, 0x11, 0x59, 0x1c,
+	0x62, 0x8f, 0x21, 0x9e, 0xa1, 0xde, 0xbc, 0x6d, 0x2a, 0xf5, 0x83, 0xfb, 0x24, 0x9d, 0x89, 0xfc,
+	0xa7, 0x0f, 0xd1, 0x8d, 0x9d, 0x9c, 0x71, 0x88, 0xa6, 0xb2, 0x96, 0x4d, 0xa9, 0x5c, 0xea, 0x11,
+	0x76, 0x8a, 0x90, 0x7b, 0x81, 0x68, 0x28, 0x36, 0x11, 0x47, 0xc8, 0x9e, 0xc2, 0xe4, 0x56, 0xd5,
+	0x6a, 0x25, 0x8d, 0xaa, 0x66, 0x1a, 0xb7, 0x2e, 0x7c, 0x48, 0xb2, 0x77, 0x70, 0xc7, 0x11, 0xeb,
+	0x66, 0x45, 0x65, 0x3e, 0xad, 0x31, 0x77, 0x6b, 0x74, 0xff, 0xa0, 0x18, 0x16, 0xd9, 0x8d, 0xfe,
+	0xe6, 0x64, 0x19, 0x8c, 0x4f, 0xcd, 0x17, 0xc8, 0x03, 0xca, 0xeb, 0x53, 0xec, 0x16, 0x92, 0xb3,
+	0x67, 0x81, 0x3c, 0xa4, 0xac, 0xec, 0x9f, 0x59, 0x0b, 0xb4, 0x49, 0x03, 0x17, 0x7b, 0x06, 0x11,
+	0x3d, 0x85, 0x6a, 0x79, 0x44, 0x0d, 0x12, 0xd7, 0x80, 0x58, 0x71, 0x14, 0xbb, 0x97, 0x9a, 0x63,
+	0xb7, 0xb3, 0x4b, 0xfb, 0x52, 0x04, 0x58, 0x0e, 0xc9, 0x67, 0x59, 0xaf, 0x2b, 0x69, 0x50, 0xbf,
+	0x57, 0x07, 0x1e, 0xd3, 0xda, 0x06, 0x5c, 0xfa, 0x11, 0xee, 0xff, 0x65, 0xe0, 0xfe, 0xbd, 0xc4,
+	0xf6, 0x5e, 0x9e, 0xf4, 0xef, 0x65, 0x7c, 0x3d, 0x19, 0x1c, 0x5f, 0xef, 0x7c, 0xd2, 0x39, 0xdc,
+	0xfb, 0x63, 0xac, 0xff, 0xe8, 0xf7, 0x25, 0xa4, 0x8f, 0xe1, 0xc5, 0xaf, 0x01, 0x00, 0x68, 0x95,
+	0x1c, 0xdf, 0x1d, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,26 @@
+package state
+
+import (
+	"bytes"
+
+	"github.com/Fantom-foundation/go-lachesis/src/hash"
+)
+
+// Delegation is a record of delegated stake.
+type Delegation struct {
+	Peer   hash.Peer
+	Amount uint64
+	Until  uint64
+}
+
+// delegations is a sortable by peer and expiration list.
+type delegations []*Delegation
+
+func (dd delegations) Len() int      { return len(dd) }
+func (dd delegations) Swap(i, j int) { dd[i], dd[j] = dd[j], dd[i] }
+func (dd delegations) Less(i, j int) bool {
+	if cmp := bytes.Compare(dd[i].Peer.Bytes(), dd[j].Peer.Bytes()); cmp != 0 {
+		return cmp < 0
+	}
+	return dd[i].Until < dd[j].Until
+}
@@ -95,10 +95,28 @@ type (
 		amount  int64
 		until   uint64
 	}
+	undelegationChange struct {
+		account *hash.Peer
+		addr    hash.Peer
+		amount  int64
+		until   uint64
+	}
 	expirationChange struct {
 		account *hash.Peer
 		deleted [2]map[string]map[uint64]uint64
 	}
+	nonceChange struct {
+		account *hash.Peer
+		prev    uint64
+	}
+	validatorKeyChange struct {
+		account *hash.Peer
+		prev    []byte
+	}
+	slashChange struct {
+		account *hash.Peer
+		prev    int
+	}
 
 	// Changes to other state values.
 	addPreimageChange struct {
@@ -169,6 +187,14 @@ func (ch delegationChange) dirtied() *hash.Peer {
 	return ch.account
 }
 
+func (ch undelegationChange) revert(s *DB) {
+	s.getStateObject(*ch.account).delegateTo(ch.addr, ch.amount, ch.until, false)
+}
+
+func (ch undelegationChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
 func (ch expirationChange) revert(s *DB) {
 	s.getStateObject(*ch.account).addDelegations(ch.deleted)
 }
@@ -177,6 +203,31 @@ func (ch expirationChange) dirtied() *hash.Peer {
 	return ch.account
 }
 
+func (ch nonceChange) revert(s *DB) {
+	s.getStateObject(*ch.account).data.Nonce = ch.prev
+}
+
+func (ch nonceChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
+func (ch validatorKeyChange) revert(s *DB) {
+	s.getStateObject(*ch.account).data.ValidatorKey = ch.prev
+}
+
+func (ch validatorKeyChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
+func (ch slashChange) revert(s *DB) {
+	obj := s.getStateObject(*ch.account)
+	obj.data.Slashes = obj.data.Slashes[:ch.prev]
+}
+
+func (ch slashChange) dirtied() *hash.Peer {
+	return ch.account
+}
+
 func (ch addPreimageChange) revert(s *DB) {
 	delete(s.preimages, ch.hash)
 }
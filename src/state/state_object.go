@@ -2,6 +2,7 @@ package state
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/Fantom-foundation/go-lachesis/src/hash"
 )
@@ -16,6 +17,9 @@ const (
 	FROM
 )
 
+// maxDelegatedRatio limits stake delegated to account by its free balance.
+const maxDelegatedRatio = 15
+
 // Storage of entries.
 type Storage map[hash.Hash]hash.Hash
 
@@ -71,7 +75,7 @@ type stateObject struct {
 
 // empty returns whether the account is considered empty.
 func (s *stateObject) empty() bool {
-	return s.data.Balance == 0
+	return s.data.Balance == 0 && s.data.Nonce == 0 && len(s.data.ValidatorKey) == 0
 }
 
 // newObject creates a state object.
@@ -249,7 +253,7 @@ func (s *stateObject) DelegateTo(addr hash.Peer, amount int64, until uint64) {
 	if amount > 0 && uint64(amount) >= s.FreeBalance() {
 		panic("Too many for delegate to!")
 	}
-	if amount < 0 && s.data.DelegatedFrom+uint64(-1*amount) >= 15*s.FreeBalance() {
+	if amount < 0 && s.data.DelegatedFrom+uint64(-1*amount) >= maxDelegatedRatio*s.FreeBalance() {
 		panic("Too many for delegate from!")
 	}
 
@@ -262,6 +266,21 @@ func (s *stateObject) DelegateTo(addr hash.Peer, amount int64, until uint64) {
 	s.delegateTo(addr, amount, until, false)
 }
 
+// UndelegateTo erases part of delegation.
+func (s *stateObject) UndelegateTo(addr hash.Peer, amount int64, until uint64) {
+	if addr == s.address || amount == 0 {
+		panic("Impossible undelegation!")
+	}
+
+	s.db.journal.append(undelegationChange{
+		account: &s.address,
+		addr:    addr,
+		amount:  amount,
+		until:   until,
+	})
+	s.delegateTo(addr, amount, until, true)
+}
+
 func (s *stateObject) delegateTo(addr hash.Peer, amount int64, until uint64, reverse bool) {
 	if s.data.DelegatingTo == nil {
 		s.data.DelegatingTo = make(map[string]*Borrow)
@@ -374,6 +393,35 @@ func (s *stateObject) GetDelegations() (dd [2]map[hash.Peer]uint64) {
 	return
 }
 
+// AddSlash records slashing.
+func (s *stateObject) AddSlash(rec *Slash) {
+	s.db.journal.append(slashChange{
+		account: &s.address,
+		prev:    len(s.data.Slashes),
+	})
+	s.data.Slashes = append(s.data.Slashes, rec)
+}
+
+func (s *stateObject) GetDelegationRecs() (dd [2][]*Delegation) {
+	get := func(x direction, delegating map[string]*Borrow) {
+		for addr, rec := range delegating {
+			h := hash.HexToPeer(addr)
+			for until, amount := range rec.Recs {
+				dd[x] = append(dd[x], &Delegation{
+					Peer:   h,
+					Amount: amount,
+					Until:  until,
+				})
+			}
+		}
+		sort.Sort(delegations(dd[x]))
+	}
+
+	get(TO, s.data.DelegatingTo)
+	get(FROM, s.data.DelegatingFrom)
+	return
+}
+
 func (s *stateObject) deepCopy(db *DB) *stateObject {
 	stateObject := newObject(db, s.address, s.data)
 	if s.trie != nil {
@@ -395,6 +443,34 @@ func (s *stateObject) Address() hash.Peer {
 	return s.address
 }
 
+// SetNonce sets the last applied transaction nonce.
+func (s *stateObject) SetNonce(nonce uint64) {
+	s.db.journal.append(nonceChange{
+		account: &s.address,
+		prev:    s.data.Nonce,
+	})
+	s.data.Nonce = nonce
+}
+
+// Nonce returns the last applied transaction nonce.
+func (s *stateObject) Nonce() uint64 {
+	return s.data.Nonce
+}
+
+// SetValidatorKey sets the registered public key of validator.
+func (s *stateObject) SetValidatorKey(pubKey []byte) {
+	s.db.journal.append(validatorKeyChange{
+		account: &s.address,
+		prev:    s.data.ValidatorKey,
+	})
+	s.data.ValidatorKey = pubKey
+}
+
+// ValidatorKey returns the registered public key of validator.
+func (s *stateObject) ValidatorKey() []byte {
+	return s.data.ValidatorKey
+}
+
 // FreeBalance returns free balance.
 func (s *stateObject) FreeBalance() uint64 {
 	return s.data.Balance - s.data.DelegatedTo
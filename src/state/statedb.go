@@ -245,6 +245,30 @@ func (s *DB) Delegate(from, to hash.Peer, amount, until uint64) {
 	t.DelegateTo(from, -1*int64(amount), until)
 }
 
+// Undelegate erases delegation records before expiration.
+func (s *DB) Undelegate(from, to hash.Peer, amount, until uint64) {
+	f := s.GetOrNewStateObject(from)
+	t := s.GetOrNewStateObject(to)
+
+	f.UndelegateTo(to, int64(amount), until)
+	t.UndelegateTo(from, -1*int64(amount), until)
+}
+
+// CanDelegate returns true if delegation limits allow it.
+func (s *DB) CanDelegate(from, to hash.Peer, amount uint64) bool {
+	if from == to || amount == 0 {
+		return false
+	}
+	f := s.getStateObject(from)
+	t := s.getStateObject(to)
+	if f == nil || t == nil {
+		return false
+	}
+
+	return amount < f.FreeBalance() &&
+		t.data.DelegatedFrom+amount < maxDelegatedRatio*t.FreeBalance()
+}
+
 // ExpireDelegations erases data about expired delegations.
 func (s *DB) ExpireDelegations(addr hash.Peer, now uint64) {
 	stateObject := s.GetOrNewStateObject(addr)
@@ -257,6 +281,120 @@ func (s *DB) GetDelegations(addr hash.Peer) [2]map[hash.Peer]uint64 {
 	return stateObject.GetDelegations()
 }
 
+// Slash takes percent of free balance of addr and of stakes delegated to addr.
+// Slashed amount goes to beneficiary, each loss is recorded in the accounts
+// with index of the addr's forked event as an offence.
+// It returns total slashed amount.
+func (s *DB) Slash(addr, beneficiary hash.Peer, percent, block, forkIndex uint64) (total uint64) {
+	if percent > 100 {
+		percent = 100
+	}
+	cheater := s.GetOrNewStateObject(addr)
+	b := s.GetOrNewStateObject(beneficiary)
+
+	own := cheater.FreeBalance() * percent / 100
+	cheater.SubBalance(own)
+	b.AddBalance(own)
+	cheater.AddSlash(&Slash{
+		Block:    block,
+		Offender:  addr.Hex(),
+		Amount:    own,
+		ForkIndex: forkIndex,
+	})
+	total += own
+
+	// copy records because undelegation modifies them
+	from := make(map[string]map[uint64]uint64, len(cheater.data.DelegatingFrom))
+	for hex, rec := range cheater.data.DelegatingFrom {
+		from[hex] = make(map[uint64]uint64, len(rec.Recs))
+		for until, amount := range rec.Recs {
+			from[hex][until] = amount
+		}
+	}
+
+	for hex, recs := range from {
+		delegator := s.GetOrNewStateObject(hash.HexToPeer(hex))
+		var lost uint64
+		for until, amount := range recs {
+			cut := amount * percent / 100
+			if cut == 0 {
+				continue
+			}
+			delegator.UndelegateTo(addr, int64(cut), until)
+			cheater.UndelegateTo(delegator.Address(), -1*int64(cut), until)
+			delegator.SubBalance(cut)
+			lost += cut
+		}
+		if lost == 0 {
+			continue
+		}
+		b.AddBalance(lost)
+		delegator.AddSlash(&Slash{
+			Block:    block,
+			Offender:  addr.Hex(),
+			Amount:    lost,
+			ForkIndex: forkIndex,
+		})
+		total += lost
+	}
+
+	return
+}
+
+// GetNonce returns the last applied transaction nonce of account or 0 if object not found.
+func (s *DB) GetNonce(addr hash.Peer) uint64 {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.Nonce()
+	}
+	return 0
+}
+
+// SetNonce sets the last applied transaction nonce of account.
+func (s *DB) SetNonce(addr hash.Peer, nonce uint64) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.SetNonce(nonce)
+}
+
+// GetValidatorKey returns the registered public key of validator or nil if object not found.
+func (s *DB) GetValidatorKey(addr hash.Peer) []byte {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.ValidatorKey()
+	}
+	return nil
+}
+
+// SetValidatorKey sets the registered public key of validator.
+func (s *DB) SetValidatorKey(addr hash.Peer, pubKey []byte) {
+	stateObject := s.GetOrNewStateObject(addr)
+	if stateObject == nil {
+		panic("stateObject is nil")
+	}
+	stateObject.SetValidatorKey(pubKey)
+}
+
+// GetSlashes returns slashing records of account.
+func (s *DB) GetSlashes(addr hash.Peer) []*Slash {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return nil
+	}
+	return stateObject.data.Slashes
+}
+
+// GetDelegationRecs returns delegation records with their expiration.
+func (s *DB) GetDelegationRecs(addr hash.Peer) (dd [2][]*Delegation) {
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+	return stateObject.GetDelegationRecs()
+}
+
 // SetState sets stateObject's kv-state by address.
 func (s *DB) SetState(addr hash.Peer, key, value hash.Hash) {
 	stateObject := s.GetOrNewStateObject(addr)
@@ -397,6 +535,20 @@ func (s *DB) ForEachStorage(addr hash.Peer, cb func(key, value hash.Hash) bool)
 	}
 }
 
+// ForEachAccount calls func for each committed account while it returns true.
+func (s *DB) ForEachAccount(cb func(addr hash.Peer) bool) {
+	it := trie.NewIterator(s.trie.NodeIterator(nil))
+	for it.Next() {
+		key := s.trie.GetKey(it.Key)
+		if key == nil {
+			continue
+		}
+		if !cb(hash.Peer(hash.FromBytes(key))) {
+			return
+		}
+	}
+}
+
 // Copy creates a deep, independent copy of the state.
 // Snapshots of the copied state cannot be applied to the copy.
 func (s *DB) Copy() *DB {
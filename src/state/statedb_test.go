@@ -193,3 +193,141 @@ func TestDelegationState(t *testing.T) {
 	check(FROM, root, aa[1], 15, __, 00)
 	check(FROM, root, aa[2], 00, 25, __)
 }
+
+func TestForEachAccount(t *testing.T) {
+	assert := assert.New(t)
+
+	var aa = []hash.Peer{
+		hash.FakePeer(),
+		hash.FakePeer(),
+		hash.FakePeer(),
+	}
+
+	store := NewDatabase(kvdb.NewMemDatabase())
+
+	db, err := New(hash.Hash{}, store)
+	if !assert.NoError(err) {
+		return
+	}
+	for i, a := range aa {
+		db.SetBalance(a, uint64(i+1))
+	}
+	root, err := db.Commit(true)
+	if !assert.NoError(err) {
+		return
+	}
+
+	db, err = New(root, store)
+	if !assert.NoError(err) {
+		return
+	}
+	got := make(map[hash.Peer]uint64)
+	db.ForEachAccount(func(addr hash.Peer) bool {
+		got[addr] = db.FreeBalance(addr)
+		return true
+	})
+
+	assert.Equal(map[hash.Peer]uint64{
+		aa[0]: 1,
+		aa[1]: 2,
+		aa[2]: 3,
+	}, got)
+}
+
+func TestSlash(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		cheater     = hash.FakePeer()
+		delegator   = hash.FakePeer()
+		beneficiary = hash.FakePeer()
+	)
+
+	db, err := New(hash.Hash{}, NewDatabase(kvdb.NewMemDatabase()))
+	if !assert.NoError(err) {
+		return
+	}
+	db.SetBalance(cheater, 100)
+	db.SetBalance(delegator, 100)
+	db.Delegate(delegator, cheater, 40, 10)
+	db.Delegate(delegator, cheater, 20, 20)
+
+	revision := db.Snapshot()
+
+	total := db.Slash(cheater, beneficiary, 50, 5, 3)
+
+	assert.Equal(uint64(50+20+10), total)
+	assert.Equal(uint64(50), db.FreeBalance(cheater))
+	assert.Equal(uint64(50+30), db.VoteBalance(cheater))
+	assert.Equal(uint64(100-60), db.FreeBalance(delegator), "free part is not slashed")
+	assert.Equal(uint64(100-60), db.VoteBalance(delegator))
+	assert.Equal(uint64(80), db.FreeBalance(beneficiary))
+	assert.Equal(map[hash.Peer]uint64{delegator: 30}, db.GetDelegations(cheater)[FROM])
+	assert.Equal(map[hash.Peer]uint64{cheater: 30}, db.GetDelegations(delegator)[TO])
+
+	assert.Equal([]*Slash{{Block: 5, Offender: cheater.Hex(), Amount: 50, ForkIndex: 3}}, db.GetSlashes(cheater))
+	assert.Equal([]*Slash{{Block: 5, Offender: cheater.Hex(), Amount: 30, ForkIndex: 3}}, db.GetSlashes(delegator))
+
+	db.RevertToSnapshot(revision)
+
+	assert.Equal(uint64(100+60), db.VoteBalance(cheater))
+	assert.Equal(uint64(40), db.FreeBalance(delegator))
+	assert.Equal(map[hash.Peer]uint64{delegator: 60}, db.GetDelegations(cheater)[FROM])
+	assert.Empty(db.GetSlashes(cheater))
+	assert.Empty(db.GetSlashes(delegator))
+}
+
+func TestUndelegate(t *testing.T) {
+	assert := assert.New(t)
+
+	var aa = []hash.Peer{
+		hash.FakePeer(),
+		hash.FakePeer(),
+	}
+
+	db, err := New(hash.Hash{}, NewDatabase(kvdb.NewMemDatabase()))
+	if !assert.NoError(err) {
+		return
+	}
+	db.SetBalance(aa[0], 100)
+	db.SetBalance(aa[1], 10)
+
+	assert.True(db.CanDelegate(aa[0], aa[1], 99))
+	assert.False(db.CanDelegate(aa[0], aa[1], 100), "all the free balance")
+	assert.False(db.CanDelegate(aa[0], aa[0], 10), "to yourself")
+	assert.False(db.CanDelegate(aa[1], hash.FakePeer(), 1), "receiver has no balance")
+
+	db.Delegate(aa[0], aa[1], 30, 20)
+	db.Delegate(aa[0], aa[1], 10, 10)
+
+	assert.Equal([]*Delegation{
+		{Peer: aa[1], Amount: 10, Until: 10},
+		{Peer: aa[1], Amount: 30, Until: 20},
+	}, db.GetDelegationRecs(aa[0])[TO])
+	assert.Equal([]*Delegation{
+		{Peer: aa[0], Amount: 10, Until: 10},
+		{Peer: aa[0], Amount: 30, Until: 20},
+	}, db.GetDelegationRecs(aa[1])[FROM])
+
+	revision := db.Snapshot()
+
+	db.Undelegate(aa[0], aa[1], 10, 20)
+
+	assert.Equal(uint64(100-30), db.FreeBalance(aa[0]))
+	assert.Equal(uint64(10+30), db.VoteBalance(aa[1]))
+	assert.Equal([]*Delegation{
+		{Peer: aa[1], Amount: 10, Until: 10},
+		{Peer: aa[1], Amount: 20, Until: 20},
+	}, db.GetDelegationRecs(aa[0])[TO])
+
+	db.Undelegate(aa[0], aa[1], 10, 10)
+
+	assert.Equal([]*Delegation{
+		{Peer: aa[0], Amount: 20, Until: 20},
+	}, db.GetDelegationRecs(aa[1])[FROM])
+
+	db.RevertToSnapshot(revision)
+
+	assert.Equal(uint64(100-40), db.FreeBalance(aa[0]))
+	assert.Equal(uint64(10+40), db.VoteBalance(aa[1]))
+}